@@ -1,6 +1,7 @@
 package aeon
 
 import (
+    "fmt"
     "math"
     "time"
 )
@@ -10,6 +11,11 @@ var (
     DefaultWeekStarts = time.Monday
     // DefaultTimeZone Parse() 使用的默认时区
     DefaultTimeZone = time.Local
+    // DefaultTimeAnchor 仅有时间的字符串 (如 "13:14:05") 默认的日期取法，也用于 Scan 的 TIME 列。
+    // 默认为所在时区的今天；设为 AnchorZero 可恢复标准库的 0000-01-01，设为 AnchorError 则拒绝没有日期的字符串。
+    DefaultTimeAnchor = AnchorToday
+    // DefaultDSTPolicy 级联结果落在夏令时跳过或重复的时段时默认的处理方式，可由 Earlier 等标志按次覆盖
+    DefaultDSTPolicy = DSTCompatible
 )
 
 type Time struct {
@@ -373,6 +379,17 @@ func (t Time) ToString(f ...string) string {
     return t.time.Format(DTNs)
 }
 
+// ISOWeekDate 返回 ISO 8601 周日期，如 "2025-W10-3" (星期一为 1，星期日为 7)。
+func (t Time) ISOWeekDate() string {
+    y, w := t.time.ISOWeek()
+    return fmt.Sprintf("%04d-W%02d-%d", y, w, (t.time.Weekday()+6)%7+1)
+}
+
+// OrdinalDate 返回 ISO 8601 序数日期，如 "2025-045"。
+func (t Time) OrdinalDate() string {
+    return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay())
+}
+
 // --- Aeon 包方法 ---
 
 // Pick 从时间集合中挑选出一个满足特定条件的极值。
@@ -2,6 +2,25 @@ package aeon
 
 import (
     "testing"
+    "time"
 )
 
 func Benchmark_Func(b *testing.B) {}
+
+func BenchmarkParseE(b *testing.B) {
+    for _, s := range []string{"2025-01-02 15:04:05", "2025-01-02T15:04:05Z", "2025-01-02 15:04:05 Asia/Shanghai"} {
+        b.Run(s, func(b *testing.B) {
+            for i := 0; i < b.N; i++ {
+                _, _ = ParseE(s)
+            }
+        })
+    }
+}
+
+func BenchmarkCascadeZone(b *testing.B) {
+    ny, _ := LoadZone("America/New_York")
+    t := Aeon(time.Date(2025, 5, 15, 10, 30, 0, 0, ny))
+    for i := 0; i < b.N; i++ {
+        _ = t.StartMonth().EndDay()
+    }
+}
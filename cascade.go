@@ -35,18 +35,26 @@ const (
     ABS = flagSign | (1 << 4)
     // Qtr 季度周标志 (基于季度索引)
     Qtr = flagSign | (1 << 5)
+
+    // Compatible、Earlier、Later、Reject 结果落在夏令时跳过或重复的时段时的处理方式，
+    // 分别对应 DSTCompatible、DSTEarlier、DSTLater、DSTReject，未指定时使用 DefaultDSTPolicy。
+    Compatible = flagSign | (1 << 6)
+    Earlier    = flagSign | (1 << 7)
+    Later      = flagSign | (1 << 8)
+    Reject     = flagSign | (1 << 9)
 )
 
 // Flag 承载级联操作的上下文配置
 type Flag struct {
-    isoWeek  bool // [ISO] 周标志 (遵循 ISO 周规则)
-    fullWeek bool // [完整] 周标志 (从本月首周一开始)
-    ordWeek  bool // [序数] 周标志 (从本月1日开始)
-    qtrWeek  bool // [季度] 周标志 (基于季度索引)
-    overflow bool // 是否允许溢出
-    abs      bool // 是否绝对年模式
-    fill     bool // 是否置满时间
-    goMode   bool // 是否跳转模式
+    isoWeek  bool      // [ISO] 周标志 (遵循 ISO 周规则)
+    fullWeek bool      // [完整] 周标志 (从本月首周一开始)
+    ordWeek  bool      // [序数] 周标志 (从本月1日开始)
+    qtrWeek  bool      // [季度] 周标志 (基于季度索引)
+    overflow bool      // 是否允许溢出
+    abs      bool      // 是否绝对年模式
+    fill     bool      // 是否置满时间
+    goMode   bool      // 是否跳转模式
+    dst      DSTPolicy // 夏令时转换处的处理方式
 }
 
 // cascade 级联时间核心引擎
@@ -58,7 +66,7 @@ func cascade(t Time, f Action, fill bool, u Unit, mask int, args ...int) Time {
     sw := t.weekStarts
 
     // 🦬 级解析：提取首位参数的位掩码标志位
-    c := Flag{fill: fill, goMode: f >= goAbs}
+    c := Flag{fill: fill, goMode: f >= goAbs, dst: DefaultDSTPolicy}
 
     if len(args) > 0 && args[0] < flagThreshold {
         mask |= args[0] // 合并传入标志与参数中的标志
@@ -72,6 +80,7 @@ func cascade(t Time, f Action, fill bool, u Unit, mask int, args ...int) Time {
         c.qtrWeek = mask&Qtr == Qtr
         c.overflow = mask&Overflow == Overflow
         c.abs = mask&ABS == ABS
+        c.dst = dstFlag(mask, c.dst)
     }
 
     if len(args) == 0 {
@@ -102,10 +111,26 @@ func cascade(t Time, f Action, fill bool, u Unit, mask int, args ...int) Time {
         y, m, d, h, mm, s, ns = align(c, p, y, m, d, h, mm, s, ns)
     }
 
-    return Time{
-        time:       time.Date(y, time.Month(m), d, h, mm, s, ns, t.Location()),
-        weekStarts: t.weekStarts,
+    r, err := resolve(y, m, d, h, mm, s, ns, t.Location(), c.dst, t.time, c.fill && !c.goMode)
+    if err != nil { // Reject: 结果落在跳过或重复的时段
+        return Time{weekStarts: t.weekStarts}
+    }
+    return Time{time: r, weekStarts: t.weekStarts}
+}
+
+// dstFlag 返回标志位指定的夏令时处理方式，未指定时返回 def
+func dstFlag(mask int, def DSTPolicy) DSTPolicy {
+    switch {
+    case mask&Reject == Reject:
+        return DSTReject
+    case mask&Later == Later:
+        return DSTLater
+    case mask&Earlier == Earlier:
+        return DSTEarlier
+    case mask&Compatible == Compatible:
+        return DSTCompatible
     }
+    return def
 }
 
 // a 归零时间
@@ -0,0 +1,185 @@
+package aeon
+
+import (
+    "database/sql/driver"
+    "fmt"
+    "time"
+)
+
+// Clock 不含日期与时区的钟面时间，如营业时间、排班开始时间、闹钟，精度为纳秒。
+//
+// 取值范围 [00:00:00, 24:00:00)，零值为 00:00:00，Clock 可以用 == 比较。
+// 加减运算按 24 小时循环：23:00 加 2 小时为 01:00；需要时刻时，使用 On 显式指定日期与时区。
+type Clock struct {
+    ns int64 // 距零点的纳秒数
+}
+
+// dayNanos 一天的纳秒数
+const dayNanos = int64(24 * time.Hour)
+
+// clockLayout 是 Clock 的文本格式，秒的小数部分去除末尾的 0
+const clockLayout = "15:04:05.999999999"
+
+// NewClock 返回指定时分秒的钟面时间，超出范围的值按 24 小时循环，如 NewClock(25, 0, 0) 为 01:00:00
+func NewClock(h, m, s int, ns ...int) Clock {
+    d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+    if len(ns) > 0 {
+        d += time.Duration(ns[0])
+    }
+    return clockOf(int64(d))
+}
+
+// ToClock 返回 t 在其所在时区的钟面时间
+func (t Time) ToClock() Clock {
+    h, m, s := t.time.Clock()
+    return NewClock(h, m, s, t.time.Nanosecond())
+}
+
+// ParseClockE 解析钟面时间字符串，返回 Clock 和 error。
+//
+// 支持 ParseE 的所有格式，如 "15:04"、"3:04 PM"、"15时04分"；带有日期时忽略日期部分，带有时区时取该时区的钟面时间。
+// 按严格模式解析，超出范围的值 (如 "25:00") 返回 ErrRange；仅有时间的字符串不受 DefaultTimeAnchor 影响。
+func ParseClockE(s string) (Clock, error) {
+    t, err := clockParser().ParseE(s)
+    return t.ToClock(), err
+}
+
+// ParseClock 解析钟面时间字符串，忽略错误
+func ParseClock(s string) Clock {
+    c, _ := ParseClockE(s)
+    return c
+}
+
+// clockParser 返回解析钟面时间的解析器：UTC，严格模式 (不进位 25:00 等超出范围的值)，仅有时间时日期为 0000-01-01
+func clockParser() Parser {
+    return Parser{Location: time.UTC, ParseOptions: ParseOptions{Strict: true, Anchor: AnchorZero}}
+}
+
+// clockOf 将纳秒数按 24 小时取模
+func clockOf(ns int64) Clock {
+    if ns %= dayNanos; ns < 0 {
+        ns += dayNanos
+    }
+    return Clock{ns}
+}
+
+// --- 获取时间 ---
+
+func (c Clock) Hour() int               { return int(c.ns / int64(time.Hour)) }
+func (c Clock) Minute() int             { return int(c.ns % int64(time.Hour) / int64(time.Minute)) }
+func (c Clock) Second() int             { return int(c.ns % int64(time.Minute) / int64(time.Second)) }
+func (c Clock) Nanosecond() int         { return int(c.ns % int64(time.Second)) }
+func (c Clock) Clock() (h, m, s int)    { return c.Hour(), c.Minute(), c.Second() }
+func (c Clock) Duration() time.Duration { return time.Duration(c.ns) } // 距零点的时长
+
+// Truncate 返回 c 向下取整到 d 的整数倍的钟面时间，如按 15 分钟对齐排班；d <= 0 时返回 c
+func (c Clock) Truncate(d time.Duration) Clock {
+    if d <= 0 {
+        return c
+    }
+    return Clock{c.ns - c.ns%int64(d)}
+}
+
+// On 返回钟面时间 c 在日期 d、时区 loc 中的时刻，同 d.At(c, loc) (夏令时转换处按 DefaultDSTPolicy 处理)
+func (c Clock) On(d Date, loc *time.Location) Time {
+    return d.At(c, loc)
+}
+
+// --- 时间运算 ---
+
+// Add 返回 c 加上 d 后的钟面时间，按 24 小时循环
+func (c Clock) Add(d time.Duration) Clock {
+    return clockOf(c.ns + int64(d)%dayNanos)
+}
+
+// Sub 返回从 u 到 c 向后经过的时长，范围 [0, 24h)，如 06:00.Sub(22:00) 为 8 小时
+func (c Clock) Sub(u Clock) time.Duration {
+    return time.Duration(clockOf(c.ns - u.ns).ns)
+}
+
+// Compare 比较 c 与 u (同一天内)：c 早于 u 返回 -1，晚于 u 返回 +1，相同返回 0
+func (c Clock) Compare(u Clock) int {
+    switch {
+    case c.ns < u.ns:
+        return -1
+    case c.ns > u.ns:
+        return 1
+    }
+    return 0
+}
+
+func (c Clock) Lt(u Clock) bool { return c.ns < u.ns }
+func (c Clock) Gt(u Clock) bool { return c.ns > u.ns }
+
+// Between 判断 c 是否在 [start, end] 之间 (包含两端)。
+//
+// start 晚于 end 时表示跨越零点的区间，如 22:00 - 06:00 包含 23:30 与 05:00。
+func (c Clock) Between(start, end Clock) bool {
+    if start.ns <= end.ns {
+        return c.ns >= start.ns && c.ns <= end.ns
+    }
+    return c.ns >= start.ns || c.ns <= end.ns
+}
+
+// --- 格式化与序列化 ---
+
+// Format 按布局格式化钟面时间，布局中的日期部分为 0000-01-01
+func (c Clock) Format(layout string) string { return c.midnight().Format(layout) }
+
+// String 返回 "15:04:05"，有小数秒时带上小数部分 (去除末尾的 0)
+func (c Clock) String() string { return c.Format(clockLayout) }
+
+func (c Clock) AppendFormat(b []byte, layout string) []byte {
+    return c.midnight().AppendFormat(b, layout)
+}
+
+// midnight 返回 0000-01-01 UTC 当天的时间，用于格式化
+func (c Clock) midnight() time.Time {
+    return time.Date(0, time.January, 1, 0, 0, 0, int(c.ns), time.UTC)
+}
+
+func (c Clock) MarshalJSON() ([]byte, error) {
+    b := make([]byte, 0, len(clockLayout)+2)
+    b = append(b, '"')
+    b = c.AppendFormat(b, clockLayout)
+    return append(b, '"'), nil
+}
+
+// UnmarshalJSON 解析 JSON 字符串，null 或空字符串为零值 (00:00:00)
+func (c *Clock) UnmarshalJSON(b []byte) error {
+    t, err := clockParser().ParseBytesE(b)
+    *c = t.ToClock()
+    return err
+}
+
+func (c Clock) MarshalText() ([]byte, error) {
+    return c.AppendFormat(nil, clockLayout), nil
+}
+
+func (c *Clock) UnmarshalText(data []byte) error {
+    return c.UnmarshalJSON(data)
+}
+
+// Scan 实现 sql.Scanner，支持 TIME 列返回的文本与 time.Time (取其所在时区的钟面时间)，NULL 为零值
+func (c *Clock) Scan(value any) error {
+    var t Time
+    var err error
+    switch v := value.(type) {
+    case nil:
+    case time.Time:
+        t = Aeon(v)
+    case []byte:
+        t, err = clockParser().ParseBytesE(v)
+    case string:
+        t, err = clockParser().ParseE(v)
+    default:
+        return fmt.Errorf("aeon: cannot scan %T into Clock", value)
+    }
+    *c = t.ToClock()
+    return err
+}
+
+// Value 实现 driver.Valuer，以 "15:04:05" 文本写入 (有小数秒时带上小数部分)
+func (c Clock) Value() (driver.Value, error) {
+    return c.String(), nil
+}
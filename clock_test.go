@@ -0,0 +1,158 @@
+package aeon
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	c := NewClock(9, 30, 15, 500)
+	if h, m, s := c.Clock(); h != 9 || m != 30 || s != 15 || c.Nanosecond() != 500 {
+		t.Fatalf("NewClock: got %d:%d:%d.%d", h, m, s, c.Nanosecond())
+	}
+	for _, v := range []struct {
+		got  Clock
+		want string
+	}{
+		{NewClock(25, 0, 0), "01:00:00"},
+		{NewClock(0, -1, 0), "23:59:00"},
+		{NewClock(0, 90, 0), "01:30:00"},
+		{NewClock(12, 0, 0, 1500000), "12:00:00.0015"},
+		{Parse("2025-01-02 15:04:05", time.UTC).ToClock(), "15:04:05"},
+		{Time{}.ToClock(), "00:00:00"},
+	} {
+		if v.got.String() != v.want {
+			t.Errorf("got %s, want %s", v.got, v.want)
+		}
+	}
+
+	t.Run("Arithmetic", func(t *testing.T) {
+		night := NewClock(22, 0, 0)
+		cases := []struct {
+			name string
+			got  Clock
+			want string
+		}{
+			{"Add(3h)", night.Add(3 * time.Hour), "01:00:00"},
+			{"Add(-23h)", night.Add(-23 * time.Hour), "23:00:00"},
+			{"Add(49h)", night.Add(49 * time.Hour), "23:00:00"},
+			{"Add(-49h)", night.Add(-49 * time.Hour), "21:00:00"},
+			{"Truncate(15m)", NewClock(9, 44, 59).Truncate(15 * time.Minute), "09:30:00"},
+		}
+		for _, v := range cases {
+			if v.got.String() != v.want {
+				t.Errorf("%s: got %s, want %s", v.name, v.got, v.want)
+			}
+		}
+
+		if d := NewClock(6, 0, 0).Sub(night); d != 8*time.Hour {
+			t.Errorf("Sub 跨零点: got %v", d)
+		}
+		if d := night.Sub(NewClock(6, 0, 0)); d != 16*time.Hour {
+			t.Errorf("Sub: got %v", d)
+		}
+		if d := night.Sub(night); d != 0 {
+			t.Errorf("Sub 相同: got %v", d)
+		}
+		if !night.Gt(NewClock(6, 0, 0)) || !NewClock(6, 0, 0).Lt(night) || night.Compare(NewClock(22, 0, 0)) != 0 {
+			t.Error("Compare")
+		}
+	})
+
+	t.Run("Between", func(t *testing.T) {
+		open, close := NewClock(9, 0, 0), NewClock(18, 0, 0)
+		nightStart, nightEnd := NewClock(22, 0, 0), NewClock(6, 0, 0)
+		cases := []struct {
+			at         Clock
+			day, night bool
+		}{
+			{NewClock(9, 0, 0), true, false},
+			{NewClock(12, 0, 0), true, false},
+			{NewClock(18, 0, 0), true, false},
+			{NewClock(21, 59, 59), false, false},
+			{NewClock(22, 0, 0), false, true},
+			{NewClock(23, 30, 0), false, true},
+			{NewClock(0, 0, 0), false, true},
+			{NewClock(5, 0, 0), false, true},
+			{NewClock(6, 0, 0), false, true},
+			{NewClock(6, 0, 1), false, false},
+		}
+		for _, v := range cases {
+			if got := v.at.Between(open, close); got != v.day {
+				t.Errorf("%s in 09:00-18:00: got %v", v.at, got)
+			}
+			if got := v.at.Between(nightStart, nightEnd); got != v.night {
+				t.Errorf("%s in 22:00-06:00: got %v", v.at, got)
+			}
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		defer func(old TimeAnchor) { DefaultTimeAnchor = old }(DefaultTimeAnchor)
+		DefaultTimeAnchor = AnchorError
+
+		for in, want := range map[string]string{
+			"15:04":                     "15:04:00",
+			"15:04:05.123":              "15:04:05.123",
+			"3:04 PM":                   "15:04:00",
+			"下午3点04分":                   "15:04:00",
+			"2025-01-02T23:30:00-05:00": "23:30:00",
+		} {
+			if got, err := ParseClockE(in); err != nil || got.String() != want {
+				t.Errorf("%s: got %s, %v", in, got, err)
+			}
+		}
+		if _, err := ParseClockE("25:00"); err == nil {
+			t.Error("25:00: want error")
+		}
+	})
+
+	t.Run("On", func(t *testing.T) {
+		sh, _ := LoadZone(Shanghai)
+		d := NewDate(2025, 3, 9)
+		at := NewClock(9, 30, 0).On(d, sh)
+		assert(t, at, "2025-03-09 09:30:00", "On")
+		assertZone(t, at, 8*3600, "On")
+		if at.ToDate() != d || at.ToClock() != NewClock(9, 30, 0) {
+			t.Errorf("ToDate/ToClock: got %s", at)
+		}
+	})
+
+	t.Run("Serialize", func(t *testing.T) {
+		type shift struct {
+			Start Clock `json:"start"`
+			End   Clock `json:"end"`
+		}
+		b, _ := json.Marshal(shift{NewClock(22, 0, 0), NewClock(6, 0, 0, 250000000)})
+		if string(b) != `{"start":"22:00:00","end":"06:00:00.25"}` {
+			t.Errorf("Marshal: got %s", b)
+		}
+
+		var s shift
+		if err := json.Unmarshal([]byte(`{"start":"22:00","end":null}`), &s); err != nil {
+			t.Fatal(err)
+		}
+		if s.Start != NewClock(22, 0, 0) || s.End != (Clock{}) {
+			t.Errorf("Unmarshal: got %+v", s)
+		}
+
+		var sc Clock
+		if err := sc.Scan([]byte("08:15:30")); err != nil || sc != NewClock(8, 15, 30) {
+			t.Errorf("Scan []byte: got %s, %v", sc, err)
+		}
+		if err := sc.Scan("08:15:30.5"); err != nil || sc != NewClock(8, 15, 30, 500000000) {
+			t.Errorf("Scan string: got %s, %v", sc, err)
+		}
+		east := time.Date(0, 1, 1, 8, 15, 0, 0, NewOffset(8*3600))
+		if err := sc.Scan(east); err != nil || sc != NewClock(8, 15, 0) {
+			t.Errorf("Scan time.Time: got %s, %v", sc, err)
+		}
+		if err := sc.Scan(int64(1)); err == nil {
+			t.Error("Scan int64: want error")
+		}
+		if v, _ := NewClock(8, 15, 0).Value(); v != "08:15:00" {
+			t.Errorf("Value: got %v", v)
+		}
+	})
+}
@@ -0,0 +1,343 @@
+package aeon
+
+import (
+    "database/sql/driver"
+    "time"
+)
+
+// Date 不含时间与时区的公历日期，如生日、到期日、节假日。
+//
+// 与在 DefaultTimeZone 零点表示日期的 Time 不同，Date 不会因服务所在时区不同而偏移一天；
+// 需要时刻时，使用 At 显式指定时间与时区。
+//
+// 零值 Date{} 表示无日期 (不同于 0001-01-01)，其级联方法返回零值，格式化结果为空字符串，比较时早于所有日期。
+// Date 可以用 == 比较。级联方法与 Time 的同名方法一致，周起始日使用 DefaultWeekStarts。
+type Date struct {
+    year  int
+    month time.Month // 仅零值为 0
+    day   int
+}
+
+// NewDate 返回指定年月日的日期，超出范围的月、日按 time.Date 的规则进位
+func NewDate(y, m, d int) Date {
+    if m >= 1 && m <= 12 && d >= 1 && d <= 28 { // 无需进位
+        return Date{y, time.Month(m), d}
+    }
+    return dateOf(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC))
+}
+
+// Today 返回 loc (默认 DefaultTimeZone) 中的今天
+func Today(loc ...*time.Location) Date {
+    return Now(loc...).ToDate()
+}
+
+// ToDate 返回 t 在其所在时区的日期 (零时为 0001-01-01)
+func (t Time) ToDate() Date {
+    return dateOf(t.time)
+}
+
+// ParseDateE 解析日期字符串，返回 Date 和 error。
+//
+// 支持 ParseE 的所有格式；带有时间时忽略时间部分，带有时区时取该时区的日期。
+// 空字符串、"null" 与无法识别的字符串返回零值。
+func ParseDateE(s string) (Date, error) {
+    t, err := ParseE(s, time.UTC)
+    if err != nil || isNull(s, t.time) {
+        return Date{}, err
+    }
+    return t.ToDate(), nil
+}
+
+// ParseDate 解析日期字符串，忽略错误
+func ParseDate(s string) Date {
+    d, _ := ParseDateE(s)
+    return d
+}
+
+func dateOf(t time.Time) Date {
+    y, m, d := t.Date()
+    return Date{y, m, d}
+}
+
+// isNull 判断由 v (字符串、字节切片或 nil) 解析得到的零时 t 是否表示空值，而非 0001-01-01 00:00:00 UTC。
+//
+// 非严格模式下，空字符串、"null" 与无法识别的字符串都解析为零时，这里按严格模式重新解析以区分。
+func isNull(v any, t time.Time) bool {
+    if !t.IsZero() {
+        return false
+    }
+
+    var s string
+    switch v := v.(type) {
+    case nil:
+        return true
+    case string:
+        s = v
+    case []byte:
+        s = btos(v)
+    default:
+        return false
+    }
+
+    if s = trim(s); s == "" || s == "null" {
+        return true
+    }
+    _, err := ParseOptions{Strict: true}.ParseE(s, time.UTC)
+    return err != nil
+}
+
+// midnight 返回 d 在 UTC 零点的时间，用于复用级联引擎 (UTC 没有夏令时，按天运算精确)
+func (d Date) midnight() Time {
+    return Time{time: time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC), weekStarts: DefaultWeekStarts}
+}
+
+// --- 获取日期 ---
+
+func (d Date) Year() int             { return d.year }
+func (d Date) Month() int            { return int(d.month) }
+func (d Date) Day() int              { return d.day }
+func (d Date) Date() (int, int, int) { return d.year, int(d.month), d.day }
+func (d Date) Days() int             { return DaysIn(d.year, int(d.month)) }
+func (d Date) IsZero() bool          { return d.month == 0 }
+func (d Date) IsLeapYear() bool      { return IsLeapYear(d.year) }
+
+// YearDay 返回一年中的第几天 (1-366)，零值返回 0
+func (d Date) YearDay() int {
+    if d.IsZero() {
+        return 0
+    }
+    return int(dateToAbsDays(int64(d.year), d.month, d.day)-dateToAbsDays(int64(d.year), time.January, 1)) + 1
+}
+
+// Weekday 返回星期，零值返回 time.Sunday (time.Weekday 的零值)
+func (d Date) Weekday() time.Weekday {
+    if d.IsZero() {
+        return time.Sunday
+    }
+    return weekday(d.year, int(d.month), d.day)
+}
+
+// ISOWeek 返回 ISO 8601 的年份与周数，零值返回 0, 0
+func (d Date) ISOWeek() (year, week int) {
+    if d.IsZero() {
+        return 0, 0
+    }
+    return d.midnight().ISOWeek()
+}
+
+// IsWeekend 是否为周末 (周六或周日)
+func (d Date) IsWeekend() bool {
+    w := d.Weekday()
+    return !d.IsZero() && (w == time.Saturday || w == time.Sunday)
+}
+
+// At 返回日期 d 在 loc 中钟面时间为 clock 的时刻，如 d.At(NewClock(9, 30, 0), loc)。
+//
+// 钟面时间落在夏令时跳过或重复的时段时按 DefaultDSTPolicy 处理，与级联方法一致；
+// 策略拒绝 (如 DSTReject) 或 d 为零值时返回零时。需要区分错误时使用 LocalDateTime.In。
+func (d Date) At(clock Clock, loc *time.Location) Time {
+    if d.IsZero() {
+        return Aeon()
+    }
+    t, err := resolve(d.year, int(d.month), d.day, 0, 0, 0, int(clock.ns), loc, DefaultDSTPolicy, time.Time{}, false)
+    if err != nil {
+        return Aeon()
+    }
+    return Aeon(t)
+}
+
+// --- 日期运算 ---
+
+// AddDays 返回 d 加上 n 天后的日期，零值返回零值
+func (d Date) AddDays(n int) Date {
+    if d.IsZero() {
+        return d
+    }
+    y, m, dd := absDaysToDate(dateToAbsDays(int64(d.year), d.month, d.day) + uint64(n))
+    return Date{y, m, dd}
+}
+
+// Sub 返回 d 与 u 相差的天数 (d - u)，任一为零值时返回 0
+func (d Date) Sub(u Date) int {
+    if d.IsZero() || u.IsZero() {
+        return 0
+    }
+    return int(dateToAbsDays(int64(d.year), d.month, d.day) - dateToAbsDays(int64(u.year), u.month, u.day))
+}
+
+// Compare 比较 d 与 u：d 早于 u 返回 -1，晚于 u 返回 +1，相同返回 0。零值早于所有日期。
+func (d Date) Compare(u Date) int {
+    switch n := d.Sub(u); {
+    case d.IsZero() || u.IsZero():
+        return btoi(u.IsZero()) - btoi(d.IsZero())
+    case n < 0:
+        return -1
+    case n > 0:
+        return 1
+    }
+    return 0
+}
+
+func (d Date) Lt(u Date) bool { return d.Compare(u) < 0 }
+func (d Date) Gt(u Date) bool { return d.Compare(u) > 0 }
+
+// Between 判断 d 是否在 [start, end] 之间 (包含两端)
+func (d Date) Between(start, end Date) bool {
+    return !d.Lt(start) && !d.Gt(end)
+}
+
+// --- 级联导航 (参数含义与 Time 的同名方法一致，仅作用于年月日) ---
+
+// via 在 d 的 UTC 零点上调用 Time 的级联方法 f，零值返回零值
+func (d Date) via(f func(Time, ...int) Time, n []int) Date {
+    if d.IsZero() {
+        return d
+    }
+    return f(d.midnight(), n...).ToDate()
+}
+
+func (d Date) StartYear(n ...int) Date    { return d.via(Time.StartYear, n) }
+func (d Date) StartQuarter(n ...int) Date { return d.via(Time.StartQuarter, n) }
+func (d Date) StartMonth(n ...int) Date   { return d.via(Time.StartMonth, n) }
+func (d Date) StartWeek(n ...int) Date    { return d.via(Time.StartWeek, n) }
+func (d Date) StartWeekday(n ...int) Date { return d.via(Time.StartWeekday, n) }
+func (d Date) StartDay(n ...int) Date     { return d.via(Time.StartDay, n) }
+
+func (d Date) EndYear(n ...int) Date    { return d.via(Time.EndYear, n) }
+func (d Date) EndQuarter(n ...int) Date { return d.via(Time.EndQuarter, n) }
+func (d Date) EndMonth(n ...int) Date   { return d.via(Time.EndMonth, n) }
+func (d Date) EndWeek(n ...int) Date    { return d.via(Time.EndWeek, n) }
+func (d Date) EndWeekday(n ...int) Date { return d.via(Time.EndWeekday, n) }
+func (d Date) EndDay(n ...int) Date     { return d.via(Time.EndDay, n) }
+
+func (d Date) StartByYear(n ...int) Date    { return d.via(Time.StartByYear, n) }
+func (d Date) StartByQuarter(n ...int) Date { return d.via(Time.StartByQuarter, n) }
+func (d Date) StartByMonth(n ...int) Date   { return d.via(Time.StartByMonth, n) }
+func (d Date) StartByWeek(n ...int) Date    { return d.via(Time.StartByWeek, n) }
+func (d Date) StartByWeekday(n ...int) Date { return d.via(Time.StartByWeekday, n) }
+func (d Date) StartByDay(n ...int) Date     { return d.via(Time.StartByDay, n) }
+
+func (d Date) EndByYear(n ...int) Date    { return d.via(Time.EndByYear, n) }
+func (d Date) EndByQuarter(n ...int) Date { return d.via(Time.EndByQuarter, n) }
+func (d Date) EndByMonth(n ...int) Date   { return d.via(Time.EndByMonth, n) }
+func (d Date) EndByWeek(n ...int) Date    { return d.via(Time.EndByWeek, n) }
+func (d Date) EndByWeekday(n ...int) Date { return d.via(Time.EndByWeekday, n) }
+func (d Date) EndByDay(n ...int) Date     { return d.via(Time.EndByDay, n) }
+
+func (d Date) StartAtYear(n ...int) Date    { return d.via(Time.StartAtYear, n) }
+func (d Date) StartAtQuarter(n ...int) Date { return d.via(Time.StartAtQuarter, n) }
+func (d Date) StartAtMonth(n ...int) Date   { return d.via(Time.StartAtMonth, n) }
+func (d Date) StartAtWeek(n ...int) Date    { return d.via(Time.StartAtWeek, n) }
+func (d Date) StartAtWeekday(n ...int) Date { return d.via(Time.StartAtWeekday, n) }
+func (d Date) StartAtDay(n ...int) Date     { return d.via(Time.StartAtDay, n) }
+
+func (d Date) EndAtYear(n ...int) Date    { return d.via(Time.EndAtYear, n) }
+func (d Date) EndAtQuarter(n ...int) Date { return d.via(Time.EndAtQuarter, n) }
+func (d Date) EndAtMonth(n ...int) Date   { return d.via(Time.EndAtMonth, n) }
+func (d Date) EndAtWeek(n ...int) Date    { return d.via(Time.EndAtWeek, n) }
+func (d Date) EndAtWeekday(n ...int) Date { return d.via(Time.EndAtWeekday, n) }
+func (d Date) EndAtDay(n ...int) Date     { return d.via(Time.EndAtDay, n) }
+
+func (d Date) StartInYear(n ...int) Date    { return d.via(Time.StartInYear, n) }
+func (d Date) StartInQuarter(n ...int) Date { return d.via(Time.StartInQuarter, n) }
+func (d Date) StartInMonth(n ...int) Date   { return d.via(Time.StartInMonth, n) }
+func (d Date) StartInWeek(n ...int) Date    { return d.via(Time.StartInWeek, n) }
+func (d Date) StartInWeekday(n ...int) Date { return d.via(Time.StartInWeekday, n) }
+func (d Date) StartInDay(n ...int) Date     { return d.via(Time.StartInDay, n) }
+
+func (d Date) EndInYear(n ...int) Date    { return d.via(Time.EndInYear, n) }
+func (d Date) EndInQuarter(n ...int) Date { return d.via(Time.EndInQuarter, n) }
+func (d Date) EndInMonth(n ...int) Date   { return d.via(Time.EndInMonth, n) }
+func (d Date) EndInWeek(n ...int) Date    { return d.via(Time.EndInWeek, n) }
+func (d Date) EndInWeekday(n ...int) Date { return d.via(Time.EndInWeekday, n) }
+func (d Date) EndInDay(n ...int) Date     { return d.via(Time.EndInDay, n) }
+
+func (d Date) GoYear(n ...int) Date    { return d.via(Time.GoYear, n) }
+func (d Date) GoQuarter(n ...int) Date { return d.via(Time.GoQuarter, n) }
+func (d Date) GoMonth(n ...int) Date   { return d.via(Time.GoMonth, n) }
+func (d Date) GoWeek(n ...int) Date    { return d.via(Time.GoWeek, n) }
+func (d Date) GoWeekday(n ...int) Date { return d.via(Time.GoWeekday, n) }
+func (d Date) GoDay(n ...int) Date     { return d.via(Time.GoDay, n) }
+
+func (d Date) AtYear(n ...int) Date    { return d.via(Time.AtYear, n) }
+func (d Date) AtQuarter(n ...int) Date { return d.via(Time.AtQuarter, n) }
+func (d Date) AtMonth(n ...int) Date   { return d.via(Time.AtMonth, n) }
+func (d Date) AtWeek(n ...int) Date    { return d.via(Time.AtWeek, n) }
+func (d Date) AtWeekday(n ...int) Date { return d.via(Time.AtWeekday, n) }
+func (d Date) AtDay(n ...int) Date     { return d.via(Time.AtDay, n) }
+
+func (d Date) InYear(n ...int) Date    { return d.via(Time.InYear, n) }
+func (d Date) InQuarter(n ...int) Date { return d.via(Time.InQuarter, n) }
+func (d Date) InMonth(n ...int) Date   { return d.via(Time.InMonth, n) }
+func (d Date) InWeek(n ...int) Date    { return d.via(Time.InWeek, n) }
+func (d Date) InDay(n ...int) Date     { return d.via(Time.InDay, n) }
+
+func (d Date) ByYear(n ...int) Date    { return d.via(Time.ByYear, n) }
+func (d Date) ByQuarter(n ...int) Date { return d.via(Time.ByQuarter, n) }
+func (d Date) ByMonth(n ...int) Date   { return d.via(Time.ByMonth, n) }
+func (d Date) ByWeek(n ...int) Date    { return d.via(Time.ByWeek, n) }
+func (d Date) ByDay(n ...int) Date     { return d.via(Time.ByDay, n) }
+
+// --- 格式化与序列化 ---
+
+// Format 按布局格式化日期，零值返回空字符串
+func (d Date) Format(layout string) string {
+    if d.IsZero() {
+        return ""
+    }
+    return d.midnight().Format(layout)
+}
+
+// String 返回 "2006-01-02"，零值返回空字符串
+func (d Date) String() string { return d.Format(time.DateOnly) }
+
+func (d Date) AppendFormat(b []byte, layout string) []byte {
+    if d.IsZero() {
+        return b
+    }
+    return d.midnight().AppendFormat(b, layout)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+    if d.IsZero() {
+        return []byte("null"), nil
+    }
+    b := make([]byte, 0, len(time.DateOnly)+2)
+    b = append(b, '"')
+    b = d.AppendFormat(b, time.DateOnly)
+    return append(b, '"'), nil
+}
+
+// UnmarshalJSON 解析 JSON 字符串，null 或空字符串为零值
+func (d *Date) UnmarshalJSON(b []byte) error {
+    t, err := ParseBytesE(b, time.UTC)
+    if *d = t.ToDate(); err != nil || isNull(b, t.time) {
+        *d = Date{}
+    }
+    return err
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+    return d.AppendFormat([]byte{}, time.DateOnly), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+    return d.UnmarshalJSON(data)
+}
+
+// Scan 实现 sql.Scanner，支持 DATE 列返回的 time.Time (取其所在时区的日期)、文本与时间戳
+func (d *Date) Scan(value any) error {
+    t, err := scan(value, Time{})
+    if *d = t.ToDate(); err != nil || isNull(value, t.time) {
+        *d = Date{}
+    }
+    return err
+}
+
+// Value 实现 driver.Valuer，以 "2006-01-02" 文本写入，避免驱动按时区转换而偏移一天
+func (d Date) Value() (driver.Value, error) {
+    if d.IsZero() {
+        return nil, nil
+    }
+    return d.String(), nil
+}
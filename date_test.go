@@ -0,0 +1,222 @@
+package aeon
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	d := NewDate(2024, 2, 29)
+	if y, m, dd := d.Date(); y != 2024 || m != 2 || dd != 29 || d.Weekday() != time.Thursday {
+		t.Fatalf("NewDate: got %d-%d-%d %v", y, m, dd, d.Weekday())
+	}
+	if got := NewDate(2024, 2, 30); got != NewDate(2024, 3, 1) {
+		t.Errorf("NewDate 进位: got %s", got)
+	}
+	if got := NewDate(2025, 13, 0); got.String() != "2025-12-31" {
+		t.Errorf("NewDate 进位: got %s", got)
+	}
+
+	t.Run("Arithmetic", func(t *testing.T) {
+		cases := []struct {
+			from string
+			n    int
+			want string
+		}{
+			{"2024-02-28", 1, "2024-02-29"},
+			{"2024-02-29", 1, "2024-03-01"},
+			{"2023-02-28", 1, "2023-03-01"},
+			{"2024-12-31", 1, "2025-01-01"},
+			{"2025-01-01", -1, "2024-12-31"},
+			{"2000-03-01", -1, "2000-02-29"},
+			{"1900-03-01", -1, "1900-02-28"},
+			{"2025-03-05", 10000, "2052-07-21"},
+			{"0400-03-01", -1, "0400-02-29"},
+		}
+		for _, c := range cases {
+			from := ParseDate(c.from)
+			got := from.AddDays(c.n)
+			if got.String() != c.want {
+				t.Errorf("%s + %d: got %s, want %s", c.from, c.n, got, c.want)
+			}
+			if diff := got.Sub(from); diff != c.n {
+				t.Errorf("%s - %s: got %d, want %d", got, c.from, diff, c.n)
+			}
+		}
+
+		a, b := NewDate(2025, 1, 1), NewDate(2025, 3, 1)
+		if !a.Lt(b) || !b.Gt(a) || a.Compare(a) != 0 || !NewDate(2025, 2, 1).Between(a, b) || !a.Between(a, b) {
+			t.Error("Compare/Between")
+		}
+		if d.YearDay() != 60 || NewDate(2024, 12, 31).YearDay() != 366 {
+			t.Errorf("YearDay: got %d", d.YearDay())
+		}
+	})
+
+	t.Run("Cascade", func(t *testing.T) {
+		ref := NewDate(2025, 3, 5) // 周三
+		cases := []struct {
+			name string
+			got  Date
+			want string
+		}{
+			{"StartMonth", ref.StartMonth(), "2025-03-01"},
+			{"EndMonth", ref.EndMonth(), "2025-03-31"},
+			{"EndQuarter", ref.EndQuarter(), "2025-03-31"},
+			{"StartYear(0, 2)", ref.StartYear(0, 2), "2025-02-01"},
+			{"EndByMonth(-1)", ref.EndByMonth(-1), "2025-02-28"},
+			{"StartWeek", ref.StartWeek(), "2025-03-03"},
+			{"EndWeek", ref.EndWeek(), "2025-03-09"},
+			{"StartInWeek(1, 5)", ref.StartInWeek(1, 5), "2025-03-14"},
+			{"StartWeekday(7)", ref.StartWeekday(7), "2025-03-09"},
+			{"GoMonth(2)", ref.GoMonth(2), "2025-02-05"},
+			{"GoWeek(Ord, -1, 5)", ref.GoWeek(Ord, -1, 5), "2025-03-28"},
+			{"ByMonth(1)", NewDate(2025, 1, 31).ByMonth(1), "2025-02-28"},
+			{"ByMonth(Overflow, 1)", NewDate(2025, 1, 31).ByMonth(Overflow, 1), "2025-03-03"},
+			{"ByYear(1)", d.ByYear(1), "2025-02-28"},
+			{"ByDay(-5)", ref.ByDay(-5), "2025-02-28"},
+			{"InMonth(1, 15)", ref.InMonth(1, 15), "2025-04-15"},
+			{"StartYear(0, 12, 25)", ref.StartYear(0, 12, 25), "2025-12-25"},
+			{"StartByQuarter(1)", ref.StartByQuarter(1), "2025-04-01"},
+		}
+		for _, c := range cases {
+			if c.got.String() != c.want {
+				t.Errorf("%s: got %s, want %s", c.name, c.got, c.want)
+			}
+		}
+	})
+
+	t.Run("At", func(t *testing.T) {
+		sh, _ := LoadZone(Shanghai)
+		ny, err := LoadZone(NewYork)
+		if err != nil {
+			t.Skip(err)
+		}
+
+		due := ParseDate("2025-03-09")
+		assert(t, due.At(NewClock(9, 30, 0), sh), "2025-03-09 09:30:00", "Shanghai")
+		assertZone(t, due.At(Clock{}, sh), 8*3600, "Shanghai")
+		assert(t, due.At(Clock{}, ny), "2025-03-09 00:00:00", "New York")
+
+		// 夏令时跳过的时间按 DefaultDSTPolicy 处理，与级联一致
+		assert(t, due.At(NewClock(2, 30, 0), ny), "2025-03-09 03:30:00", "跳过的时间")
+		assert(t, NewClock(2, 30, 0).On(due, ny), "2025-03-09 03:30:00", "On 跳过的时间")
+		if got := ParseDate("2025-11-02").At(NewClock(1, 30, 0), ny); got.Format("-07:00") != "-04:00" {
+			t.Errorf("重复的时间: got %s", got.Format(DT+" -07:00"))
+		}
+		DefaultDSTPolicy = DSTReject
+		if got := due.At(NewClock(2, 30, 0), ny); !got.IsZero() {
+			t.Errorf("DSTReject: got %s", got)
+		}
+		DefaultDSTPolicy = DSTCompatible
+
+		// 不同时区的服务得到同一日期
+		for _, loc := range []*time.Location{sh, ny, time.UTC} {
+			if got := due.At(NewClock(23, 0, 0), loc).ToDate(); got != due {
+				t.Errorf("%s: got %s", loc, got)
+			}
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		for in, want := range map[string]string{
+			"2025-01-02":                "2025-01-02",
+			"2025/1/2 15:04":            "2025-01-02",
+			"2025-01-02T23:30:00-05:00": "2025-01-02",
+			"Jan 2, 2025":               "2025-01-02",
+			"2025年1月2日":                 "2025-01-02",
+		} {
+			if got, err := ParseDateE(in); err != nil || got.String() != want {
+				t.Errorf("%s: got %s, %v", in, got, err)
+			}
+		}
+		if got := ParseDate(""); !got.IsZero() {
+			t.Errorf("empty: got %s", got)
+		}
+	})
+
+	t.Run("Serialize", func(t *testing.T) {
+		type row struct {
+			Birthday Date  `json:"birthday"`
+			Due      *Date `json:"due"`
+		}
+		b, _ := json.Marshal(row{Birthday: NewDate(1990, 5, 20)})
+		if string(b) != `{"birthday":"1990-05-20","due":null}` {
+			t.Errorf("Marshal: got %s", b)
+		}
+
+		var r row
+		if err := json.Unmarshal([]byte(`{"birthday":"1990-05-20","due":"2025-01-02"}`), &r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Birthday != NewDate(1990, 5, 20) || r.Due == nil || *r.Due != NewDate(2025, 1, 2) {
+			t.Errorf("Unmarshal: got %+v", r)
+		}
+
+		var zero Date
+		if b, _ := zero.MarshalJSON(); string(b) != "null" {
+			t.Errorf("zero: got %s", b)
+		}
+		if err := zero.UnmarshalJSON([]byte("null")); err != nil || !zero.IsZero() {
+			t.Errorf("null: got %s, %v", zero, err)
+		}
+
+		var sd Date
+		east := time.Date(2025, 1, 2, 0, 0, 0, 0, NewOffset(8*3600)) // 驱动以 +08:00 零点返回 DATE
+		if err := sd.Scan(east); err != nil || sd.String() != "2025-01-02" {
+			t.Errorf("Scan time.Time: got %s, %v", sd, err)
+		}
+		if err := sd.Scan([]byte("2025-01-03")); err != nil || sd.String() != "2025-01-03" {
+			t.Errorf("Scan []byte: got %s, %v", sd, err)
+		}
+		if err := sd.Scan(nil); err != nil || !sd.IsZero() {
+			t.Errorf("Scan nil: got %s, %v", sd, err)
+		}
+		if v, _ := NewDate(2025, 1, 2).Value(); v != "2025-01-02" {
+			t.Errorf("Value: got %v", v)
+		}
+		if v, _ := zero.Value(); v != nil {
+			t.Errorf("Value zero: got %v", v)
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		first := ParseDate("0001-01-01") // 最早的日期不是零值
+		if first.IsZero() || first != NewDate(1, 1, 1) || first.String() != "0001-01-01" {
+			t.Errorf("0001-01-01: got %s, zero %v", first, first.IsZero())
+		}
+		if v, _ := first.Value(); v != "0001-01-01" {
+			t.Errorf("0001-01-01 Value: got %v", v)
+		}
+		if got := Aeon(time.Time{}).ToDate(); got != first {
+			t.Errorf("time.Time{}: got %s", got)
+		}
+		var sd Date
+		if err := sd.UnmarshalJSON([]byte(`"0001-01-01"`)); err != nil || sd != first {
+			t.Errorf("Unmarshal 0001-01-01: got %s, %v", sd, err)
+		}
+
+		var zero Date
+		if !zero.IsZero() || zero.String() != "" || zero.Weekday() != time.Sunday || zero.YearDay() != 0 {
+			t.Errorf("Date{}: got %q %v %d", zero, zero.Weekday(), zero.YearDay())
+		}
+		if b, _ := zero.MarshalText(); len(b) != 0 {
+			t.Errorf("Date{} MarshalText: got %q", b)
+		}
+		if zero.Sub(first) != 0 || first.Sub(zero) != 0 || zero.Compare(first) != -1 || first.Compare(zero) != 1 || zero.Compare(Date{}) != 0 {
+			t.Errorf("Date{} Sub/Compare: got %d, %d, %d", zero.Sub(first), zero.Compare(first), first.Compare(zero))
+		}
+		if !zero.Lt(first) || zero.Between(first, NewDate(2025, 1, 1)) {
+			t.Error("Date{} 早于所有日期")
+		}
+		if !zero.StartMonth().IsZero() || !zero.EndYear().IsZero() || !zero.AddDays(1).IsZero() || !zero.At(Clock{}, time.UTC).IsZero() {
+			t.Error("Date{} 级联应返回零值")
+		}
+		for _, s := range []string{"", "null", " null "} {
+			if got := ParseDate(s); !got.IsZero() {
+				t.Errorf("ParseDate(%q): got %s", s, got)
+			}
+		}
+	})
+}
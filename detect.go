@@ -0,0 +1,286 @@
+package aeon
+
+import (
+    "time"
+    "unicode/utf8"
+)
+
+// fieldChars 字段序号 (年 月 日 时 分 秒) 对应的记录字符
+const fieldChars = "YMDhms"
+
+// orderFields 各字段顺序对应的记录字符
+var orderFields = [...]string{DMY: "DMY", MDY: "MDY", YMD: "YMD"}
+
+// trace 解析路径的记录，供 ParseDetect 推断布局。
+type trace struct {
+    fields [12]byte // 数字块依次对应的字段，见 record
+    n      int
+    hour12 bool   // 带有 AM/PM，小时为 12 小时制
+    layout string // 由 Layouts/Fallbacks 中的布局解析时，为该布局
+}
+
+// record 记录解析分支中数字块依次对应的字段 (仅在 ParseDetect 中生效)。
+//
+// 字段字符："Y" 年，"y" 两位年份，"M" 月，"D" 日，"j" 年内天数，"h" 时，"m" 分，"s" 秒；
+// 其他字符表示无法用 Go 布局表示 (如 "W" 周数)。末尾多余的字段可不出现在字符串中。
+func (o *ParseOptions) record(fields string) {
+    if o.tr != nil {
+        o.tr.n += copy(o.tr.fields[o.tr.n:], fields)
+    }
+}
+
+// ParseDetect 解析时间字符串，同时返回与之对应的 Go 布局 (time.Parse 格式)。
+//
+// 布局由解析时所走的分支推断，覆盖分隔符、字段位数、小数精度与偏移形式，如：
+//
+//	"2025-01-02T15:04:05.123+08:00" → "2006-01-02T15:04:05.000-07:00"
+//	"03/04/2025 15:04"              → "01/02/2006 15:04"
+//	"Jan 2, 2025 3:04 PM"           → "Jan 2, 2006 3:04 PM"
+//
+// 布局对应去除首尾空白与引号后的字符串。无法用 Go 布局表示时 (如 ISO 周日期、IANA 时区名称、
+// 中文上下午、缺少年份) 布局为空，解析结果与 ParseE 相同。
+func ParseDetect(s string, loc ...*time.Location) (Time, string, error) {
+    return NewParser().ParseDetect(s, loc...)
+}
+
+// ParseDetect 按解析器的选项解析时间字符串，同时返回与之对应的 Go 布局，见包级 ParseDetect。
+func (p Parser) ParseDetect(s string, loc ...*time.Location) (Time, string, error) {
+    var tr trace
+    p.tr = &tr
+    t, err := p.ParseE(s, loc...)
+    if err != nil || t.time.IsZero() {
+        return t, "", err
+    }
+
+    if tr.layout != "" {
+        return t, tr.layout, nil
+    }
+
+    s = trim(s)
+    layout := tr.build(s)
+    if layout == "" {
+        return t, "", nil
+    }
+
+    // 布局须能解析回相同的日期与时钟；仅有时间时日期由 Anchor 确定，只比较时钟
+    pt, perr := time.ParseInLocation(layout, s, t.time.Location())
+    if perr == nil && !tr.hasDate() {
+        y, m, d := t.time.Date()
+        pt = time.Date(y, m, d, pt.Hour(), pt.Minute(), pt.Second(), pt.Nanosecond(), pt.Location())
+    }
+    if perr != nil || !sameClock(pt, t.time) {
+        return t, "", nil
+    }
+
+    return t, layout, nil
+}
+
+// InferLayout 从一组样本 (如 CSV 的一整列) 推断共同的 Go 布局。
+//
+// 纯数字日期的字段顺序先由 InferDateOrder 统一推断，再对每个样本调用 ParseDetect，
+// 返回出现次数最多的布局 (次数相同时取先出现者)；没有可推断的样本时返回空字符串。
+func InferLayout(samples []string) string {
+    p := NewParser(ParseOptions{DateOrder: InferDateOrder(samples)})
+
+    var layouts []string
+    var votes []int
+    best := -1
+    for _, s := range samples {
+        _, layout, _ := p.ParseDetect(s)
+        if layout == "" {
+            continue
+        }
+
+        i := 0
+        for ; i < len(layouts) && layouts[i] != layout; i++ {
+        }
+        if i == len(layouts) {
+            layouts, votes = append(layouts, layout), append(votes, 0)
+        }
+        if votes[i]++; best < 0 || votes[i] > votes[best] {
+            best = i
+        }
+    }
+
+    if best < 0 {
+        return ""
+    }
+    return layouts[best]
+}
+
+// hasDate 判断记录的字段中是否有日期部分
+func (tr *trace) hasDate() bool {
+    for _, c := range tr.fields[:tr.n] {
+        switch c {
+        case 'Y', 'y', 'M', 'D', 'j':
+            return true
+        }
+    }
+    return false
+}
+
+// build 按记录的字段将 s 转换为布局，无法表示时返回空字符串。
+//
+// 数字块依次对应记录的字段；超过字段最大位数的数字块 (紧凑格式) 按固定位数拆分为多个字段。
+// 秒之后的小数转换为 ".000"，时间之后的 "Z"、±hh[:mm] 转换为偏移，名称转换为对应的布局，其余原样保留。
+func (tr *trace) build(s string) string {
+    b := make([]byte, 0, len(s)+8)
+    k, last, n := 0, byte(0), len(s)
+    for i := 0; i < n; {
+        c := s[i]
+        switch {
+        case isDigit(c):
+            for j := skipDigit(s, i); i < j; k++ {
+                if k == tr.n {
+                    return ""
+                }
+                last = tr.fields[k]
+                w := min(j-i, fieldWidth(last))
+                f := fieldLayout(last, w, tr.hour12)
+                if f == "" {
+                    return ""
+                }
+                if f == "2" && len(b) > 1 && b[len(b)-1] == ' ' && b[len(b)-2] == ' ' { // 空格补齐的日 "Jan  2"
+                    b, f = b[:len(b)-1], "_2"
+                }
+                b, i = append(b, f...), i+w
+            }
+        case (c == '.' || c == ',') && last == 's' && i+1 < n && isDigit(s[i+1]):
+            j := skipDigit(s, i+1)
+            if j-i > 10 {
+                return ""
+            }
+            b = append(b, c)
+            for i++; i < j; i++ {
+                b = append(b, '0')
+            }
+            last = 0
+        case bt[c]&kSign != 0 && (k == tr.n || isClock(last)) && isDigit2(s, i+1):
+            switch j := skipDigit(s, i+1); {
+            case j-i == 3 && j+2 < n && s[j] == ':' && isDigit2(s, j+1):
+                b, i = append(b, "-07:00"...), j+3
+            case j-i == 5:
+                b, i = append(b, "-0700"...), j
+            case j-i == 3:
+                b, i = append(b, "-07"...), j
+            default:
+                return ""
+            }
+        case c == 'Z' && k > 0 && (i+1 == n || bt[s[i+1]]&kAlpha == 0):
+            b, i = append(b, "Z07:00"...), i+1
+        case bt[c]&kAlpha != 0:
+            j := skipAlpha(s, i)
+            f := nameLayout(s[i:j], k > 0)
+            if f == "" || (j < n && s[j] == '/') { // IANA 名称
+                return ""
+            }
+            b, i = append(b, f...), j
+        case c >= utf8.RuneSelf:
+            rest := s[i:]
+            if matchAny(rest, cjkWeekdayPrefix[:]) >= 0 || matchMeridiem(rest) >= 0 {
+                return ""
+            }
+            _, size := utf8.DecodeRuneInString(rest)
+            b, i = append(b, rest[:size]...), i+size
+        case c == '[': // RFC 9557 时区后缀
+            return ""
+        default:
+            b, i = append(b, c), i+1
+        }
+    }
+
+    return string(b)
+}
+
+// fieldWidth 返回字段的最大位数
+func fieldWidth(f byte) int {
+    switch f {
+    case 'Y':
+        return 4
+    case 'j':
+        return 3
+    default:
+        return 2
+    }
+}
+
+// fieldLayout 返回 w 位数字的字段 f 对应的布局，无法表示时返回空字符串。
+func fieldLayout(f byte, w int, hour12 bool) string {
+    switch {
+    case f == 'Y' && w == 4:
+        return "2006"
+    case (f == 'Y' || f == 'y') && w == 2:
+        return "06"
+    case f == 'j' && w == 3:
+        return "002"
+    case f == 'h' && hour12:
+        return pick(w, "3", "03")
+    case f == 'h' && w == 2: // 24 小时制没有 1 位数字的布局
+        return "15"
+    case f == 'M':
+        return pick(w, "1", "01")
+    case f == 'D':
+        return pick(w, "2", "02")
+    case f == 'm':
+        return pick(w, "4", "04")
+    case f == 's':
+        return pick(w, "5", "05")
+    }
+    return ""
+}
+
+// pick 按位数选择 1 位或 2 位数字的布局
+func pick(w int, short, long string) string {
+    if w == 1 {
+        return short
+    }
+    return long
+}
+
+// isClock 判断字段是否为时间字段
+func isClock(f byte) bool { return f == 'h' || f == 'm' || f == 's' }
+
+// nameLayout 返回英文单词 w 对应的布局：月份、星期名称、AM/PM 与时区缩写 (仅在 clock 为 true 即已出现数字时)
+// 转换为对应的布局，序数词后缀无法表示，返回空字符串，其他单词原样保留。
+func nameLayout(w string, clock bool) string {
+    if m := lookupMonth(w); m > 0 {
+        switch name := time.Month(m).String(); {
+        case len(w) == 3:
+            return "Jan"
+        case eqFold(w, name):
+            return "January"
+        }
+        return ""
+    }
+
+    if d := lookupWeekday(w); d >= 0 {
+        switch name := d.String(); {
+        case len(w) == 3:
+            return "Mon"
+        case eqFold(w, name):
+            return "Monday"
+        }
+        return ""
+    }
+
+    switch {
+    case w == "AM" || w == "PM":
+        return "PM"
+    case w == "am" || w == "pm":
+        return "pm"
+    case matchFold(w, ordinals[:]):
+        return ""
+    case clock && (isAbbr(w) || rfcZone(w) != nil):
+        return "MST"
+    }
+    return w
+}
+
+// sameClock 判断 a 与 b 的日期与时钟是否相同
+func sameClock(a, b time.Time) bool {
+    ay, am, ad := a.Date()
+    by, bm, bd := b.Date()
+    ah, amm, as := a.Clock()
+    bh, bmm, bs := b.Clock()
+    return ay == by && am == bm && ad == bd && ah == bh && amm == bmm && as == bs && a.Nanosecond() == b.Nanosecond()
+}
@@ -0,0 +1,86 @@
+package aeon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDetect(t *testing.T) {
+	today := time.Now().UTC().Format("2006-01-02")
+	cases := []struct {
+		in, layout, want string
+	}{
+		{"2025-01-02", "2006-01-02", "2025-01-02 00:00:00"},
+		{"2025-01-02 15:04", "2006-01-02 15:04", "2025-01-02 15:04:00"},
+		{"2025-01-02T15:04:05.123456+08:00", "2006-01-02T15:04:05.000000-07:00", "2025-01-02 15:04:05.123456"},
+		{"2025-01-02T15:04:05Z", "2006-01-02T15:04:05Z07:00", "2025-01-02 15:04:05"},
+		{"2025-01-02 15:04:05 +0800", "2006-01-02 15:04:05 -0700", "2025-01-02 15:04:05"},
+		{"2025/1/2 13:4:5", "2006/1/2 15:4:5", "2025-01-02 13:04:05"},
+		{"20250102T150405", "20060102T150405", "2025-01-02 15:04:05"},
+		{"2025-045", "2006-002", "2025-02-14 00:00:00"},
+		{"15:04:05", "15:04:05", today + " 15:04:05"},
+		{"03/04/2025", "01/02/2006", "2025-03-04 00:00:00"},
+		{"13/04/2025 15:04", "02/01/2006 15:04", "2025-04-13 15:04:00"},
+		{"3.4.25", "2.1.06", "2025-04-03 00:00:00"},
+		{"03/04/2025 0930", "01/02/2006 1504", "2025-03-04 09:30:00"},
+		{"Jan 2, 2025 3:04 PM", "Jan 2, 2006 3:04 PM", "2025-01-02 15:04:00"},
+		{"2 January 2025", "2 January 2006", "2025-01-02 00:00:00"},
+		{"2025-01-02 Thursday", "2006-01-02 Monday", "2025-01-02 00:00:00"},
+		{"Mon, 02 Jan 2006 15:04:05 -0700", "Mon, 02 Jan 2006 15:04:05 -0700", "2006-01-02 15:04:05"},
+		{"Mon Jan  2 15:04:05 UTC 2006", "Mon Jan _2 15:04:05 MST 2006", "2006-01-02 15:04:05"},
+		{"2025年1月2日 15时04分05秒", "2006年1月2日 15时04分05秒", "2025-01-02 15:04:05"},
+		{` "2025-01-02" `, "2006-01-02", "2025-01-02 00:00:00"},
+
+		// 无法用 Go 布局表示
+		{"2025-W10-3", "", "2025-03-05 00:00:00"},
+		{"2025/1/2 3:4:5", "", "2025-01-02 03:04:05"}, // 24 小时制没有 1 位数字的小时
+		{"2025年1月2日 下午3点", "", "2025-01-02 15:00:00"},
+		{"2025-01-02T15:04:05+08:00[Asia/Shanghai]", "", "2025-01-02 15:04:05"},
+		{"the 2nd of January 2025", "", "2025-01-02 00:00:00"},
+	}
+
+	for _, c := range cases {
+		res, layout, err := ParseDetect(c.in, time.UTC)
+		if err != nil {
+			t.Errorf("%q: %v", c.in, err)
+			continue
+		}
+		if layout != c.layout {
+			t.Errorf("%q: layout got %q, want %q", c.in, layout, c.layout)
+		}
+		assert(t, res, c.want, c.in)
+	}
+
+	t.Run("Parser", func(t *testing.T) {
+		p := NewParser(ParseOptions{Layouts: []string{"02 Jan 06", "2006.01.02"}})
+		if _, layout, _ := p.ParseDetect("2025.01.02"); layout != "2006.01.02" {
+			t.Errorf("Layouts: got %q", layout)
+		}
+
+		p = NewParser(ParseOptions{DateOrder: DMY})
+		if _, layout, _ := p.ParseDetect("03/04/2025"); layout != "02/01/2006" {
+			t.Errorf("DMY: got %q", layout)
+		}
+
+		if _, layout, err := ParseDetect("banana"); layout != "" || err == nil {
+			t.Errorf("unknown: got %q, %v", layout, err)
+		}
+	})
+
+	t.Run("InferLayout", func(t *testing.T) {
+		cases := []struct {
+			samples []string
+			want    string
+		}{
+			{[]string{"03/04/2025", "05/06/2025", "25/12/2024", ""}, "02/01/2006"},
+			{[]string{"03/04/2025", "12/25/2024"}, "01/02/2006"},
+			{[]string{"2025-01-02 10:00", "2025-01-03 11:30", "2025-01-04T09:00:00Z"}, "2006-01-02 15:04"},
+			{[]string{"", "n/a", "2025-W10-3"}, ""},
+		}
+		for _, c := range cases {
+			if got := InferLayout(c.samples); got != c.want {
+				t.Errorf("%q: got %q, want %q", c.samples, got, c.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,225 @@
+package aeon
+
+import (
+    "errors"
+    "math"
+    "sync/atomic"
+    "time"
+)
+
+var (
+    // ErrNonexistent 表示钟面时间落在夏令时跳过的时段内 (如纽约 2025-03-09 02:30)
+    ErrNonexistent = errors.New("aeon: nonexistent local time in DST gap")
+    // ErrAmbiguous 表示钟面时间落在夏令时重复的时段内 (如纽约 2025-11-02 01:30)
+    ErrAmbiguous = errors.New("aeon: ambiguous local time in DST overlap")
+)
+
+// DSTPolicy 钟面时间落在夏令时转换处时的处理方式，由跳过 (Gap) 与重复 (Fold) 两部分按位或组合，
+// 如 GapBackward|FoldLater。零值为 GapForward|FoldEarlier。
+type DSTPolicy uint8
+
+const (
+    GapForward  DSTPolicy = 0 // 跳过的时间按转换前的偏移解释，即顺延跳过的时长，如 02:30 → 03:30
+    GapBackward DSTPolicy = 1 // 跳过的时间按转换后的偏移解释，即提前跳过的时长，如 02:30 → 01:30
+    GapReject   DSTPolicy = 2 // 跳过的时间返回 ErrNonexistent
+
+    FoldEarlier DSTPolicy = 0 << 2 // 重复的时间取较早的时刻 (转换前的偏移)
+    FoldLater   DSTPolicy = 1 << 2 // 重复的时间取较晚的时刻 (转换后的偏移)
+    FoldReject  DSTPolicy = 2 << 2 // 重复的时间返回 ErrAmbiguous
+
+    // DSTCompatible 跳过的时间顺延，重复的时间取较早的时刻 (与 RFC 5545、java.time 一致)。
+    // 用于级联时保持区间的含义：End 系列取区间的最后一刻，原时间位于重复时段内时保持其偏移。
+    DSTCompatible = GapForward | FoldEarlier
+    // DSTEarlier 总是取较早的时刻：跳过的时间提前，重复的时间取较早的时刻
+    DSTEarlier = GapBackward | FoldEarlier
+    // DSTLater 总是取较晚的时刻：跳过的时间顺延，重复的时间取较晚的时刻
+    DSTLater = GapForward | FoldLater
+    // DSTReject 跳过或重复的时间均返回错误 (级联方法返回零时)
+    DSTReject = GapReject | FoldReject
+
+    gapMask  DSTPolicy = 3
+    foldMask DSTPolicy = 3 << 2
+)
+
+// zoneSpan 时区 loc 中钟面时间唯一的时刻范围 [lo, hi] (Unix 秒)，即某一偏移区间去除两端各一天
+type zoneSpan struct {
+    loc    *time.Location
+    lo, hi int64
+}
+
+// lastSpan 最近一次 resolve 查询的区间
+var lastSpan atomic.Pointer[zoneSpan]
+
+// resolve 返回 loc 中钟面时间为 y-m-d h:mm:s.ns 的时刻，落在夏令时跳过或重复的时段时按 p 处理。
+// 超出范围的字段按 time.Date 的规则进位。
+//
+// time.Date 在这两种情况下的选择取决于偏移的正负 (如纽约的跳过时间提前、柏林的跳过时间顺延)，
+// 这里由转换两侧的偏移显式计算。
+//
+// from 为级联的原时间 (非级联时为零)，p 为 DSTCompatible 时用于保持区间的含义：
+//   - from 本身位于同一重复时段内时保持其偏移 (如在第二个 01:30 上调用 StartHour，结果为第二个 01:00)。
+//   - fill (End 系列) 时取区间的最后一刻：重复的时间取较晚的时刻，跳过的时间提前。
+func resolve(y, m, d, h, mm, s, ns int, loc *time.Location, p DSTPolicy, from time.Time, fill bool) (time.Time, error) {
+    t := time.Date(y, time.Month(m), d, h, mm, s, ns, loc)
+    if loc == time.UTC {
+        return t, nil
+    }
+
+    // 跳过与重复的时段都短于一天，距离两侧转换都超过一天时钟面时间唯一。
+    // 先与最近一次查询的区间比较，级联通常落在同一区间内，省去时区查询。
+    sec := t.Unix()
+    if z := lastSpan.Load(); z != nil && z.loc == loc && z.lo <= sec && sec <= z.hi {
+        return t, nil
+    }
+
+    start, end := t.ZoneBounds()
+    z := &zoneSpan{loc: loc, lo: math.MinInt64, hi: math.MaxInt64}
+    if !start.IsZero() {
+        z.lo = start.Unix() + 86400
+    }
+    if !end.IsZero() {
+        z.hi = end.Unix() - 86400
+    }
+    if lastSpan.Store(z); z.lo <= sec && sec <= z.hi {
+        return t, nil
+    }
+
+    wall := time.Date(y, time.Month(m), d, h, mm, s, ns, time.UTC) // 钟面时间按 UTC 解释的时刻
+    _, off := t.Zone()
+    compat := p == DSTCompatible && !from.IsZero()
+
+    if t.Add(time.Duration(off) * time.Second).Equal(wall) { // 钟面时间存在，检查相邻时段是否也包含它
+        other, bound, diff := t, time.Time{}, 0
+        if !start.IsZero() {
+            if _, prev := start.Add(-1).Zone(); prev != off {
+                if u := wall.Add(-time.Duration(prev) * time.Second); u.Before(start) {
+                    other, bound, diff = u, start, prev-off
+                }
+            }
+        }
+        if !end.IsZero() {
+            if _, next := end.Zone(); next != off {
+                if u := wall.Add(-time.Duration(next) * time.Second); !u.Before(end) {
+                    other, bound, diff = u, end, off-next
+                }
+            }
+        }
+        if other.Equal(t) {
+            return t, nil
+        }
+
+        if compat { // from 在重复时段 [bound-diff, bound+diff) 内时保持其偏移
+            window := time.Duration(diff) * time.Second
+            if !from.Before(bound.Add(-window)) && from.Before(bound.Add(window)) {
+                if _, keep := from.Zone(); keep != off {
+                    t = other
+                }
+                return t.In(loc), nil
+            }
+            if fill {
+                p = FoldLater
+            }
+        }
+
+        switch p & foldMask {
+        case FoldReject:
+            return time.Time{}, ErrAmbiguous
+        case FoldLater:
+            if other.After(t) {
+                t = other
+            }
+        default:
+            if other.Before(t) {
+                t = other
+            }
+        }
+        return t.In(loc), nil
+    }
+
+    // 钟面时间被跳过：t 位于跳过时段的某一侧，before/after 为转换前后的偏移
+    before, after := off, off
+    if t.Add(time.Duration(off) * time.Second).Before(wall) { // t 在转换之前
+        _, after = end.Zone()
+    } else {
+        _, before = start.Add(-1).Zone()
+    }
+
+    if compat && fill {
+        p = GapBackward
+    }
+
+    switch p & gapMask {
+    case GapReject:
+        return time.Time{}, ErrNonexistent
+    case GapBackward:
+        return wall.Add(-time.Duration(after) * time.Second).In(loc), nil
+    default:
+        return wall.Add(-time.Duration(before) * time.Second).In(loc), nil
+    }
+}
+
+// --- 时区转换 ---
+
+// Transition 时区的一次偏移或缩写变化，如夏令时开始或结束
+type Transition struct {
+    At           Time   // 变化的时刻，位于变化后的时区状态
+    BeforeName   string // 变化前的时区缩写，如 "EST"
+    BeforeOffset int    // 变化前相对 UTC 的偏移 (秒)
+    AfterName    string // 变化后的时区缩写，如 "EDT"
+    AfterOffset  int    // 变化后相对 UTC 的偏移 (秒)
+}
+
+// Shift 返回钟面时间的调整量：正数为拨快 (跳过一段钟面时间)，负数为拨慢 (重复一段钟面时间)
+func (tr Transition) Shift() time.Duration {
+    return time.Duration(tr.AfterOffset-tr.BeforeOffset) * time.Second
+}
+
+// transitionAt 返回 at 处的变化，偏移与缩写都未改变时 ok 为 false
+func transitionAt(at time.Time, ws time.Weekday) (tr Transition, ok bool) {
+    tr.At = Time{time: at, weekStarts: ws}
+    tr.BeforeName, tr.BeforeOffset = at.Add(-1).Zone()
+    tr.AfterName, tr.AfterOffset = at.Zone()
+    return tr, tr.BeforeOffset != tr.AfterOffset || tr.BeforeName != tr.AfterName
+}
+
+// NextTransition 返回 t 之后 (不含 t) 所在时区的下一次变化，没有时 ok 为 false (如 UTC、固定偏移或已废除夏令时的时区)
+func (t Time) NextTransition() (tr Transition, ok bool) {
+    for at := t.time; ; {
+        if _, at = at.ZoneBounds(); at.IsZero() {
+            return Transition{}, false
+        }
+        if tr, ok = transitionAt(at, t.weekStarts); ok {
+            return tr, true
+        }
+    }
+}
+
+// PrevTransition 返回 t 及之前所在时区的最近一次变化，没有时 ok 为 false
+func (t Time) PrevTransition() (tr Transition, ok bool) {
+    for at := t.time; ; at = at.Add(-1) {
+        if at, _ = at.ZoneBounds(); at.IsZero() {
+            return Transition{}, false
+        }
+        if tr, ok = transitionAt(at, t.weekStarts); ok {
+            return tr, true
+        }
+    }
+}
+
+// TransitionsBetween 返回 a 所在时区在 (a, b] 内的所有变化，按时间先后排列
+func TransitionsBetween(a, b Time) []Transition {
+    var trs []Transition
+    for {
+        tr, ok := a.NextTransition()
+        if !ok || tr.At.time.After(b.time) {
+            return trs
+        }
+        trs = append(trs, tr)
+        a = tr.At
+    }
+}
+
+// DayLength 返回 t 所在的一天的实际时长 (从 StartDay 到次日的 StartDay)，夏令时转换日为 23 或 25 小时等
+func (t Time) DayLength() time.Duration {
+    return t.StartByDay(Compatible, 1).Sub(t.StartByDay(Compatible))
+}
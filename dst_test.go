@@ -0,0 +1,223 @@
+package aeon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDST(t *testing.T) {
+	zone := func(t *testing.T, name string) *time.Location {
+		loc, err := LoadZone(name)
+		if err != nil {
+			t.Skip(err)
+		}
+		return loc
+	}
+	// at 返回 UTC 时刻 s 在 loc 中的时间，用于构造重复时段中的某一个
+	at := func(s string, loc *time.Location) Time {
+		return Aeon(Parse(s, time.UTC).Time().In(loc))
+	}
+	const layout = "2006-01-02 15:04:05 -07:00"
+
+	type dstCase struct {
+		name string
+		got  Time
+		want string // 空字符串表示零时 (Reject)
+	}
+	check := func(t *testing.T, cases []dstCase) {
+		t.Helper()
+		for _, c := range cases {
+			got := c.got.Format(layout)
+			if c.got.IsZero() {
+				got = ""
+			}
+			if got != c.want {
+				t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+			}
+		}
+	}
+
+	t.Run("NewYork", func(t *testing.T) {
+		ny := zone(t, NewYork)
+		spring := Parse("2025-03-08 02:30:00", ny) // 次日 02:00 → 03:00
+		fall := Parse("2025-11-01 01:30:00", ny)   // 次日 02:00 → 01:00
+		first := at("2025-11-02 05:30:00", ny)     // 01:30 EDT
+		second := at("2025-11-02 06:30:00", ny)    // 01:30 EST
+
+		check(t, []dstCase{
+			{"跳过/默认", spring.ByDay(1), "2025-03-09 03:30:00 -04:00"},
+			{"跳过/Compatible", spring.ByDay(Compatible, 1), "2025-03-09 03:30:00 -04:00"},
+			{"跳过/Earlier", spring.ByDay(Earlier, 1), "2025-03-09 01:30:00 -05:00"},
+			{"跳过/Later", spring.ByDay(Later, 1), "2025-03-09 03:30:00 -04:00"},
+			{"跳过/Reject", spring.ByDay(Reject, 1), ""},
+			{"跳过/StartHour", spring.StartByDay(1).GoHour(2), "2025-03-09 03:00:00 -04:00"},
+			{"跳过/EndHour", Parse("2025-03-09 12:00:00", ny).EndDay(0, 2), "2025-03-09 01:59:59 -05:00"},
+
+			{"重复/默认", fall.ByDay(1), "2025-11-02 01:30:00 -04:00"},
+			{"重复/Earlier", fall.ByDay(Earlier, 1), "2025-11-02 01:30:00 -04:00"},
+			{"重复/Later", fall.ByDay(Later, 1), "2025-11-02 01:30:00 -05:00"},
+			{"重复/Reject", fall.ByDay(Reject, 1), ""},
+			{"重复/StartDay", fall.ByDay(Reject, 1).StartDay(), ""},
+
+			// 原时间位于重复时段内：保持其偏移
+			{"第一个 StartHour", first.StartByHour(), "2025-11-02 01:00:00 -04:00"},
+			{"第一个 EndHour", first.EndByHour(), "2025-11-02 01:59:59 -04:00"},
+			{"第二个 StartHour", second.StartByHour(), "2025-11-02 01:00:00 -05:00"},
+			{"第二个 EndHour", second.EndByHour(), "2025-11-02 01:59:59 -05:00"},
+			{"第二个 GoMinute", second.GoMinute(5), "2025-11-02 01:05:00 -05:00"},
+			{"第二个 Earlier", second.StartByHour(Earlier), "2025-11-02 01:00:00 -04:00"},
+			{"第一个 Later", first.EndByHour(Later), "2025-11-02 01:59:59 -05:00"},
+			{"第二个 StartDay", second.StartDay(), "2025-11-02 00:00:00 -04:00"},
+			{"第一个 EndDay", first.EndDay(), "2025-11-02 23:59:59 -05:00"},
+		})
+
+		if d := first.EndByHour().Sub(first.StartByHour()); d != time.Hour-time.Nanosecond {
+			t.Errorf("第一个 01 时的长度: got %v", d)
+		}
+	})
+
+	t.Run("Berlin", func(t *testing.T) {
+		berlin := zone(t, "Europe/Berlin")
+		spring := Parse("2025-03-29 02:30:00", berlin) // 次日 02:00 → 03:00
+		fall := Parse("2025-10-25 02:30:00", berlin)   // 次日 03:00 → 02:00
+
+		check(t, []dstCase{
+			{"跳过/默认", spring.ByDay(1), "2025-03-30 03:30:00 +02:00"},
+			{"跳过/Earlier", spring.ByDay(Earlier, 1), "2025-03-30 01:30:00 +01:00"},
+			{"跳过/Reject", spring.ByDay(Reject, 1), ""},
+			{"重复/默认", fall.ByDay(1), "2025-10-26 02:30:00 +02:00"},
+			{"重复/Later", fall.ByDay(Later, 1), "2025-10-26 02:30:00 +01:00"},
+			{"重复/Reject", fall.ByDay(Reject, 1), ""},
+			{"重复/EndHour", Parse("2025-10-26 12:00:00", berlin).EndDay(0, 2), "2025-10-26 02:59:59 +01:00"},
+			{"重复/StartHour", Parse("2025-10-26 12:00:00", berlin).StartDay(0, 2), "2025-10-26 02:00:00 +02:00"},
+		})
+	})
+
+	t.Run("SaoPaulo", func(t *testing.T) {
+		sp := zone(t, "America/Sao_Paulo")
+		skipped := Parse("2018-11-04 12:00:00", sp)  // 当天 00:00 → 01:00，零点不存在
+		repeated := Parse("2019-02-16 12:00:00", sp) // 次日 00:00 → 前一天 23:00，23 时重复
+
+		check(t, []dstCase{
+			{"StartDay", skipped.StartDay(), "2018-11-04 01:00:00 -02:00"},
+			{"StartDay/Earlier", skipped.StartDay(Earlier), "2018-11-03 23:00:00 -03:00"},
+			{"StartDay/Reject", skipped.StartDay(Reject), ""},
+			{"EndDay 前一天", skipped.ByDay(-1).EndDay(), "2018-11-03 23:59:59 -03:00"},
+			{"EndDay", repeated.EndDay(), "2019-02-16 23:59:59 -03:00"},
+			{"EndDay/Earlier", repeated.EndDay(Earlier), "2019-02-16 23:59:59 -02:00"},
+			{"EndDay/Reject", repeated.EndDay(Reject), ""},
+			{"StartDay 次日", repeated.StartByDay(1), "2019-02-17 00:00:00 -03:00"},
+		})
+
+		if d := repeated.EndDay().Sub(repeated.StartDay()); d != 25*time.Hour-time.Nanosecond {
+			t.Errorf("25 小时的一天: got %v", d)
+		}
+		if d := skipped.EndDay().Sub(skipped.StartDay()); d != 23*time.Hour-time.Nanosecond {
+			t.Errorf("23 小时的一天: got %v", d)
+		}
+	})
+
+	t.Run("LordHowe", func(t *testing.T) {
+		lh := zone(t, "Australia/Lord_Howe") // 夏令时只调整 30 分钟
+		check(t, []dstCase{
+			{"跳过/默认", Parse("2025-10-04 02:15:00", lh).ByDay(1), "2025-10-05 02:45:00 +11:00"},
+			{"跳过/Earlier", Parse("2025-10-04 02:15:00", lh).ByDay(Earlier, 1), "2025-10-05 01:45:00 +10:30"},
+			{"重复/默认", Parse("2025-04-05 01:45:00", lh).ByDay(1), "2025-04-06 01:45:00 +11:00"},
+			{"重复/Later", Parse("2025-04-05 01:45:00", lh).ByDay(Later, 1), "2025-04-06 01:45:00 +10:30"},
+		})
+	})
+
+	t.Run("Span", func(t *testing.T) { // 交替在不同时区与区间中级联，最近一次查询的区间不影响结果
+		ny, berlin := zone(t, NewYork), zone(t, "Europe/Berlin")
+		fall := Parse("2025-11-01 01:30:00", ny)
+		for i := 0; i < 2; i++ {
+			check(t, []dstCase{
+				{"纽约夏季", Parse("2025-06-15 12:00:00", ny).StartMonth(), "2025-06-01 00:00:00 -04:00"},
+				{"重复/Later", fall.ByDay(Later, 1), "2025-11-02 01:30:00 -05:00"},
+				{"柏林夏季", Parse("2025-06-15 12:00:00", berlin).StartMonth(), "2025-06-01 00:00:00 +02:00"},
+				{"跳过/Earlier", Parse("2025-03-08 02:30:00", ny).ByDay(Earlier, 1), "2025-03-09 01:30:00 -05:00"},
+				{"纽约冬季", Parse("2025-12-15 12:00:00", ny).EndMonth(), "2025-12-31 23:59:59 -05:00"},
+			})
+		}
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		ny := zone(t, NewYork)
+		spring := Parse("2025-03-08 02:30:00", ny)
+
+		DefaultDSTPolicy = DSTReject
+		defer func() { DefaultDSTPolicy = DSTCompatible }()
+		check(t, []dstCase{
+			{"DSTReject", spring.ByDay(1), ""},
+			{"按次覆盖", spring.ByDay(Compatible, 1), "2025-03-09 03:30:00 -04:00"},
+			{"与其他标志组合", spring.ByDay(Earlier|Overflow, 1), "2025-03-09 01:30:00 -05:00"},
+			{"普通时间", spring.ByDay(2), "2025-03-10 02:30:00 -04:00"},
+		})
+	})
+
+	t.Run("Transitions", func(t *testing.T) {
+		ny := zone(t, NewYork)
+		summer := Parse("2025-06-01 12:00:00", ny)
+
+		next, ok := summer.NextTransition()
+		if !ok || next.At.Format(layout) != "2025-11-02 01:00:00 -05:00" ||
+			next.BeforeName != "EDT" || next.BeforeOffset != -4*3600 ||
+			next.AfterName != "EST" || next.AfterOffset != -5*3600 || next.Shift() != -time.Hour {
+			t.Errorf("NextTransition: got %+v, %v", next, ok)
+		}
+
+		prev, ok := summer.PrevTransition()
+		if !ok || prev.At.Format(layout) != "2025-03-09 03:00:00 -04:00" || prev.BeforeName != "EST" || prev.AfterName != "EDT" || prev.Shift() != time.Hour {
+			t.Errorf("PrevTransition: got %+v, %v", prev, ok)
+		}
+		if again, _ := prev.At.PrevTransition(); again.At != prev.At {
+			t.Errorf("PrevTransition 包含 t: got %s", again.At)
+		}
+		if after, _ := prev.At.NextTransition(); after.At != next.At {
+			t.Errorf("NextTransition 不含 t: got %s", after.At)
+		}
+
+		trs := TransitionsBetween(Parse("2024-01-01", ny), Parse("2026-01-01", ny))
+		want := []string{"2024-03-10 03:00:00 -04:00", "2024-11-03 01:00:00 -05:00", "2025-03-09 03:00:00 -04:00", "2025-11-02 01:00:00 -05:00"}
+		if len(trs) != len(want) {
+			t.Fatalf("TransitionsBetween: got %d, want %d", len(trs), len(want))
+		}
+		for i, tr := range trs {
+			if tr.At.Format(layout) != want[i] {
+				t.Errorf("TransitionsBetween[%d]: got %s, want %s", i, tr.At.Format(layout), want[i])
+			}
+		}
+		if trs := TransitionsBetween(summer, summer.ByMonth(1)); len(trs) != 0 {
+			t.Errorf("TransitionsBetween 无变化: got %v", trs)
+		}
+
+		sh := zone(t, Shanghai)
+		if _, ok := Parse("2025-01-01", sh).NextTransition(); ok {
+			t.Error("Shanghai: 不应有下一次变化")
+		}
+		if tr, ok := Parse("2025-01-01", sh).PrevTransition(); !ok || tr.At.Year() != 1991 || tr.AfterOffset != 8*3600 {
+			t.Errorf("Shanghai PrevTransition: got %+v, %v", tr, ok)
+		}
+		if _, ok := Parse("2025-01-01", time.UTC).NextTransition(); ok {
+			t.Error("UTC: 不应有下一次变化")
+		}
+
+		sp := zone(t, "America/Sao_Paulo")
+		for _, c := range []struct {
+			t    Time
+			want time.Duration
+		}{
+			{Parse("2025-03-09 12:00:00", ny), 23 * time.Hour},
+			{Parse("2025-11-02 00:00:00", ny), 25 * time.Hour},
+			{summer, 24 * time.Hour},
+			{Parse("2018-11-04 12:00:00", sp), 23 * time.Hour}, // 零点被跳过
+			{Parse("2019-02-16 12:00:00", sp), 25 * time.Hour}, // 23 时重复
+			{Parse("2025-10-05 12:00:00", zone(t, "Australia/Lord_Howe")), 23*time.Hour + 30*time.Minute},
+			{Parse("2025-01-01", time.UTC), 24 * time.Hour},
+		} {
+			if got := c.t.DayLength(); got != c.want {
+				t.Errorf("DayLength(%s): got %v, want %v", c.t, got, c.want)
+			}
+		}
+	})
+}
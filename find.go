@@ -0,0 +1,427 @@
+package aeon
+
+import (
+    "time"
+)
+
+// Match 文本中找到的一处时间
+type Match struct {
+    Start, End int    // 时间在原文中的字节区间 s[Start:End]
+    Time       Time   // 解析结果
+    Layout     string // 与匹配文本对应的布局 (time.Parse 格式)
+}
+
+// layoutBuf 匹配过程中拼接布局的缓冲区，仅在匹配成功时转换为字符串。
+type layoutBuf struct {
+    b [64]byte
+    n int
+}
+
+func (l *layoutBuf) add(s string) { l.n += copy(l.b[l.n:], s) }
+
+// pad 写入 1 位或 2 位数字对应的布局，如 "1"/"01"。
+func (l *layoutBuf) pad(digits int, short, long string) {
+    if digits == 1 {
+        l.add(short)
+    } else {
+        l.add(long)
+    }
+}
+
+// Find 在文本 s 中查找第一处时间，返回其区间、解析结果与布局。
+//
+// 支持的形式：
+//   - ISO 8601 及其变体："2006-01-02"、"2006/01/02 15:04:05.000"、"2006-01-02T15:04:05Z07:00"
+//   - 紧凑格式："20060102"、"20060102150405"、"20060102T150405"
+//   - nginx/Apache 访问日志："02/Jan/2006:15:04:05 -0700"
+//   - syslog (RFC 3164)："Jan  2 15:04:05"，年份取 loc 中的当前年份
+//   - ANSIC、UnixDate 与 RFC 1123："Mon Jan  2 15:04:05 2006"、"Mon, 02 Jan 2006 15:04:05 MST"
+//
+// 时间须以单词边界开始。不含时区的时间使用 loc (默认 DefaultTimeZone)。未找到时不分配内存。
+func Find(s string, loc ...*time.Location) (Match, bool) {
+    return find(s, 0, findZone(loc))
+}
+
+// FindAll 按出现顺序返回文本 s 中所有不重叠的时间，未找到时返回 nil。
+func FindAll(s string, loc ...*time.Location) []Match {
+    l := findZone(loc)
+
+    var ms []Match
+    for i := 0; ; {
+        m, ok := find(s, i, l)
+        if !ok {
+            return ms
+        }
+        ms = append(ms, m)
+        i = m.End
+    }
+}
+
+func findZone(loc []*time.Location) *time.Location {
+    if len(loc) > 0 && loc[0] != nil {
+        return loc[0]
+    }
+    return DefaultTimeZone
+}
+
+// find 从 s[i:] 开始查找第一处时间
+func find(s string, i int, loc *time.Location) (Match, bool) {
+    const word = kDigit | kAlpha
+    for ; i < len(s); i++ {
+        c := bt[s[i]]
+        if c&word == 0 || (i > 0 && bt[s[i-1]]&word != 0) {
+            continue
+        }
+
+        var (
+            lb  layoutBuf
+            end int
+            t   time.Time
+            ok  bool
+        )
+        if c&kDigit != 0 {
+            end, t, ok = findNumeric(s, i, loc, &lb)
+        } else {
+            end, t, ok = findNamed(s, i, loc, &lb)
+        }
+
+        if ok {
+            return Match{
+                Start:  i,
+                End:    end,
+                Time:   Time{time: t, weekStarts: DefaultWeekStarts},
+                Layout: string(lb.b[:lb.n]),
+            }, true
+        }
+    }
+    return Match{}, false
+}
+
+// findNumeric 匹配以数字开头的时间：ISO、紧凑格式与访问日志格式。
+func findNumeric(s string, i int, loc *time.Location, lb *layoutBuf) (int, time.Time, bool) {
+    switch n := skipDigit(s, i) - i; {
+    case n == 4 && i+4 < len(s) && (s[i+4] == '-' || s[i+4] == '/' || s[i+4] == '.'):
+        return findISO(s, i, loc, lb)
+    case n == 8 || n == 14:
+        return findCompact(s, i, n, loc, lb)
+    case n == 2 && i+2 < len(s) && s[i+2] == '/':
+        return findCLF(s, i, loc, lb)
+    }
+    return 0, time.Time{}, false
+}
+
+// findISO 匹配 "YYYY-MM-DD[(T| )hh:mm[:ss[.nnn]]][Z|±hh:mm|±hhmm]"，日期分隔符可为 '-'、'/' 或 '.'。
+func findISO(s string, i int, loc *time.Location, lb *layoutBuf) (int, time.Time, bool) {
+    y, sep := p4(s[i:]), s[i+4]
+    lb.add("2006")
+    lb.add(s[i+4 : i+5])
+
+    j := i + 5
+    e := skipDigit(s, j)
+    if e-j < 1 || e-j > 2 || e >= len(s) || s[e] != sep {
+        return 0, time.Time{}, false
+    }
+    m := num(s, j, e)
+    lb.pad(e-j, "1", "01")
+    lb.add(s[e : e+1])
+
+    j = e + 1
+    if e = skipDigit(s, j); e-j < 1 || e-j > 2 {
+        return 0, time.Time{}, false
+    }
+    d := num(s, j, e)
+    lb.pad(e-j, "2", "02")
+
+    if !validDate(y, m, d) {
+        return 0, time.Time{}, false
+    }
+
+    j = e
+    h, mm, sec, ns := 0, 0, 0, 0
+    if j+1 < len(s) && (s[j] == 'T' || s[j] == ' ') && isDigit2(s, j+1) {
+        save := lb.n
+        lb.add(s[j : j+1])
+        if ch, cm, cs, cn, ce, ok := findClock(s, j+1, lb); ok {
+            h, mm, sec, ns, j = ch, cm, cs, cn, ce
+        } else {
+            lb.n = save // 仅有日期
+        }
+    }
+
+    l, j := findOffset(s, j, loc, lb)
+    if j < len(s) && bt[s[j]]&kDigit != 0 {
+        return 0, time.Time{}, false
+    }
+    return j, time.Date(y, time.Month(m), d, h, mm, sec, ns, l), true
+}
+
+// findCompact 匹配 "YYYYMMDD"、"YYYYMMDDhhmmss" 与 "YYYYMMDDThhmmss"，年份限定为 1900-2099 以减少误报。
+func findCompact(s string, i, n int, loc *time.Location, lb *layoutBuf) (int, time.Time, bool) {
+    y, m, d := p4(s[i:]), p2(s, i+4), p2(s, i+6)
+    if y < 1900 || y > 2099 || !validDate(y, m, d) {
+        return 0, time.Time{}, false
+    }
+    lb.add("20060102")
+
+    j, clock := i+8, true
+    switch {
+    case n == 14:
+        lb.add("150405")
+    case j+7 <= len(s) && s[j] == 'T' && skipDigit(s, j+1) == j+7:
+        lb.add("T150405")
+        j++
+    default:
+        clock = false
+    }
+
+    h, mm, sec, ns := 0, 0, 0, 0
+    if clock {
+        if h, mm, sec = p2(s, j), p2(s, j+2), p2(s, j+4); h > 23 || mm > 59 || sec > 59 {
+            return 0, time.Time{}, false
+        }
+        var ok bool
+        if ns, j, ok = findFrac(s, j+6, lb); !ok {
+            return 0, time.Time{}, false
+        }
+    }
+
+    l, j := findOffset(s, j, loc, lb)
+    if j < len(s) && bt[s[j]]&(kDigit|kAlpha) != 0 {
+        return 0, time.Time{}, false
+    }
+    return j, time.Date(y, time.Month(m), d, h, mm, sec, ns, l), true
+}
+
+// findCLF 匹配 nginx/Apache 通用日志格式 "02/Jan/2006:15:04:05 -0700"
+func findCLF(s string, i int, loc *time.Location, lb *layoutBuf) (int, time.Time, bool) {
+    d, j := p2(s, i), i+3
+    m := 0
+    if j+4 < len(s) && s[j+3] == '/' {
+        m = lookupMonth(s[j : j+3])
+    }
+    if m == 0 || !isDigit4(s[j+4:]) || j+8 >= len(s) || s[j+8] != ':' {
+        return 0, time.Time{}, false
+    }
+    y := p4(s[j+4:])
+    if !validDate(y, m, d) {
+        return 0, time.Time{}, false
+    }
+
+    lb.add("02/Jan/2006:")
+    h, mm, sec, ns, j, ok := findClock(s, j+9, lb)
+    if !ok {
+        return 0, time.Time{}, false
+    }
+
+    l, j := findOffset(s, j, loc, lb)
+    return j, time.Date(y, time.Month(m), d, h, mm, sec, ns, l), true
+}
+
+// findNamed 匹配以月份或星期名称开头的时间：syslog、ANSIC、UnixDate 与 RFC 1123。
+func findNamed(s string, i int, loc *time.Location, lb *layoutBuf) (int, time.Time, bool) {
+    if j := skipAlpha(s, i); j-i != 3 {
+        return 0, time.Time{}, false
+    }
+
+    if lookupMonth(s[i:i+3]) > 0 { // syslog: Jan _2 15:04:05
+        m, d, h, mm, sec, ns, j, ok := findStamp(s, i, lb)
+        if !ok {
+            return 0, time.Time{}, false
+        }
+        y := time.Now().In(loc).Year()
+        if !validDate(y, m, d) {
+            return 0, time.Time{}, false
+        }
+        return j, time.Date(y, time.Month(m), d, h, mm, sec, ns, loc), true
+    }
+
+    if lookupWeekday(s[i:i+3]) < 0 || i+4 >= len(s) {
+        return 0, time.Time{}, false
+    }
+
+    var j int
+    if s[i+3] == ',' { // RFC 1123: Mon, 02 Jan 2006 15:04:05 MST
+        j = findRFC1123(s, i+3, lb)
+    } else { // ANSIC/UnixDate: Mon Jan _2 15:04:05 [MST ]2006
+        j = findANSIC(s, i+3, lb)
+    }
+    if j < 0 {
+        return 0, time.Time{}, false
+    }
+
+    t, err := parseRFC(s[i:j], loc, &ParseOptions{})
+    return j, t, err == nil
+}
+
+// findRFC1123 匹配星期名称之后的 ", 02 Jan 2006 15:04:05 (MST|-0700)"，返回结束位置，失败返回 -1。
+func findRFC1123(s string, j int, lb *layoutBuf) int {
+    if j+2 >= len(s) || s[j+1] != ' ' {
+        return -1
+    }
+    lb.add("Mon, ")
+
+    j += 2
+    e := skipDigit(s, j)
+    if e-j < 1 || e-j > 2 || e+1 >= len(s) || s[e] != ' ' {
+        return -1
+    }
+    lb.pad(e-j, "2", "02")
+
+    j = e + 1
+    if e = skipAlpha(s, j); e-j != 3 || lookupMonth(s[j:e]) == 0 || e+6 >= len(s) || s[e] != ' ' ||
+        !isDigit4(s[e+1:]) || s[e+5] != ' ' {
+        return -1
+    }
+    lb.add(" Jan 2006 ")
+
+    _, _, _, _, j, ok := findClock(s, e+6, lb)
+    if !ok || j+1 >= len(s) || s[j] != ' ' {
+        return -1
+    }
+
+    j++
+    switch c := s[j]; {
+    case bt[c]&kSign != 0 && j+5 <= len(s) && isDigit4(s[j+1:]):
+        lb.add(" -0700")
+        j += 5
+    case bt[c]&kAlpha != 0:
+        if e = skipAlpha(s, j); e-j > 5 {
+            return -1
+        }
+        lb.add(" MST")
+        j = e
+    default:
+        return -1
+    }
+    return j
+}
+
+// findANSIC 匹配星期名称之后的 " Jan _2 15:04:05 [MST ]2006"，返回结束位置，失败返回 -1。
+func findANSIC(s string, j int, lb *layoutBuf) int {
+    if s[j] != ' ' {
+        return -1
+    }
+    lb.add("Mon ")
+
+    _, _, _, _, _, _, j, ok := findStamp(s, j+1, lb)
+    if !ok || j+1 >= len(s) || s[j] != ' ' {
+        return -1
+    }
+
+    j++
+    if e := skipAlpha(s, j); e > j { // UnixDate 时区缩写
+        if e-j > 5 || e+1 >= len(s) || s[e] != ' ' {
+            return -1
+        }
+        lb.add(" MST")
+        j = e + 1
+    }
+
+    if !isDigit4(s[j:]) || (j+4 < len(s) && bt[s[j+4]]&kDigit != 0) {
+        return -1
+    }
+    lb.add(" 2006")
+    return j + 4
+}
+
+// findStamp 匹配 syslog 时间戳 "Jan _2 15:04:05[.nnn]" (日期可为 1-2 位，以 1-2 个空格分隔)。
+func findStamp(s string, i int, lb *layoutBuf) (m, d, h, mm, sec, ns, j int, ok bool) {
+    if i+3 >= len(s) || s[i+3] != ' ' {
+        return
+    }
+    if m = lookupMonth(s[i : i+3]); m == 0 {
+        return
+    }
+
+    j = i + 4
+    if j < len(s) && s[j] == ' ' {
+        j++
+    }
+    e := skipDigit(s, j)
+    if e-j < 1 || e-j > 2 || e+1 >= len(s) || s[e] != ' ' {
+        return
+    }
+    d = num(s, j, e)
+    lb.add("Jan _2 ")
+
+    h, mm, sec, ns, j, ok = findClock(s, e+1, lb)
+    return
+}
+
+// findClock 匹配 "hh:mm[:ss[.nnn]]"，小数部分分隔符可为 '.' 或 ','。
+func findClock(s string, i int, lb *layoutBuf) (h, mm, sec, ns, j int, ok bool) {
+    if !isDigit2(s, i) || i+5 > len(s) || s[i+2] != ':' || !isDigit2(s, i+3) {
+        return
+    }
+    if h, mm = p2(s, i), p2(s, i+3); h > 23 || mm > 59 {
+        return
+    }
+    lb.add("15:04")
+
+    j = i + 5
+    if j+2 < len(s) && s[j] == ':' && isDigit2(s, j+1) {
+        if sec = p2(s, j+1); sec > 59 {
+            return
+        }
+        lb.add(":05")
+        if ns, j, ok = findFrac(s, j+3, lb); !ok {
+            return
+        }
+    }
+
+    if j < len(s) && bt[s[j]]&kDigit != 0 {
+        return
+    }
+    return h, mm, sec, ns, j, true
+}
+
+// findFrac 匹配 j 处可选的秒小数部分 ".nnn" 或 ",nnn" (至多 9 位)，返回纳秒与结束位置。
+func findFrac(s string, j int, lb *layoutBuf) (ns, e int, ok bool) {
+    if j+1 >= len(s) || (s[j] != '.' && s[j] != ',') || !isDigit(s[j+1]) {
+        return 0, j, true
+    }
+    if e = skipDigit(s, j+1); e-j > 10 {
+        return 0, e, false
+    }
+    lb.add(s[j : j+1])
+    lb.add("000000000"[:e-j-1])
+    ns, _ = parseNanoseconds(s, e, j)
+    return ns, e, true
+}
+
+// findOffset 匹配 j 处可选的时区：'Z'、"±hh:mm"、"±hhmm" 或 " ±hhmm"，返回时区与结束位置。
+func findOffset(s string, j int, loc *time.Location, lb *layoutBuf) (*time.Location, int) {
+    if j >= len(s) {
+        return loc, j
+    }
+
+    if s[j] == 'Z' && (j+1 == len(s) || bt[s[j+1]]&(kDigit|kAlpha) == 0) {
+        lb.add("Z07:00")
+        return time.UTC, j + 1
+    }
+
+    sp := 0
+    if s[j] == ' ' {
+        sp = 1
+    }
+    k := j + sp
+    if k+5 > len(s) || bt[s[k]]&kSign == 0 || !isDigit2(s, k+1) {
+        return loc, j
+    }
+
+    sign, h := 44-int(s[k]), p2(s, k+1)
+    switch {
+    case sp == 0 && k+6 <= len(s) && s[k+3] == ':' && isDigit2(s, k+4):
+        lb.add("-07:00")
+        return NewOffset((h*3600 + p2(s, k+4)*60) * sign), k + 6
+    case isDigit2(s, k+3) && (k+5 == len(s) || bt[s[k+5]]&kDigit == 0):
+        lb.add(" -0700"[1-sp:])
+        return NewOffset((h*3600 + p2(s, k+3)*60) * sign), k + 5
+    }
+    return loc, j
+}
+
+// validDate 判断年月日是否有效
+func validDate(y, m, d int) bool {
+    return m >= 1 && m <= 12 && d >= 1 && d <= DaysIn(y, m)
+}
@@ -0,0 +1,125 @@
+package aeon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFind(t *testing.T) {
+	cases := []struct {
+		in, text, layout, want string
+		offset                 int
+	}{
+		{
+			`127.0.0.1 - - [02/Jan/2006:15:04:05 -0700] "GET / HTTP/1.1" 200 612`,
+			"02/Jan/2006:15:04:05 -0700", "02/Jan/2006:15:04:05 -0700", "2006-01-02 15:04:05", -7 * 3600,
+		},
+		{
+			"level=info ts=2025-01-02T15:04:05.123Z msg=started",
+			"2025-01-02T15:04:05.123Z", "2006-01-02T15:04:05.000Z07:00", "2025-01-02 15:04:05.123", 0,
+		},
+		{
+			"2025-01-02 15:04:05,250 INFO  [main] app started",
+			"2025-01-02 15:04:05,250", "2006-01-02 15:04:05,000", "2025-01-02 15:04:05.25", 0,
+		},
+		{
+			"deployed at 2025/1/2 8:30 by ci",
+			"2025/1/2", "2006/1/2", "2025-01-02 00:00:00", 0,
+		},
+		{
+			"event(2025-01-02T15:04:05+08:00)",
+			"2025-01-02T15:04:05+08:00", "2006-01-02T15:04:05-07:00", "2025-01-02 15:04:05", 8 * 3600,
+		},
+		{
+			"written 2025-01-02 15:04:05 +0800 by job",
+			"2025-01-02 15:04:05 +0800", "2006-01-02 15:04:05 -0700", "2025-01-02 15:04:05", 8 * 3600,
+		},
+		{
+			"id=20250102150405 status=ok",
+			"20250102150405", "20060102150405", "2025-01-02 15:04:05", 0,
+		},
+		{
+			"backup_20250102T150405.tar",
+			"20250102T150405", "20060102T150405", "2025-01-02 15:04:05", 0,
+		},
+		{
+			"Date: Mon, 02 Jan 2006 15:04:05 GMT\r\n",
+			"Mon, 02 Jan 2006 15:04:05 GMT", "Mon, 02 Jan 2006 15:04:05 MST", "2006-01-02 15:04:05", 0,
+		},
+		{
+			"built Mon Jan  2 15:04:05 2006 on host",
+			"Mon Jan  2 15:04:05 2006", "Mon Jan _2 15:04:05 2006", "2006-01-02 15:04:05", 0,
+		},
+		{
+			"Mon Jan  2 15:04:05 UTC 2006",
+			"Mon Jan  2 15:04:05 UTC 2006", "Mon Jan _2 15:04:05 MST 2006", "2006-01-02 15:04:05", 0,
+		},
+	}
+
+	for _, c := range cases {
+		m, ok := Find(c.in, time.UTC)
+		if !ok {
+			t.Errorf("%q: no match", c.in)
+			continue
+		}
+		if got := c.in[m.Start:m.End]; got != c.text {
+			t.Errorf("%q: span got %q, want %q", c.in, got, c.text)
+		}
+		if m.Layout != c.layout {
+			t.Errorf("%q: layout got %q, want %q", c.in, m.Layout, c.layout)
+		}
+		assert(t, m.Time, c.want, c.in)
+		assertZone(t, m.Time, c.offset, c.in)
+
+		// 布局可原样解析匹配文本
+		if pt, err := time.ParseInLocation(m.Layout, c.text, time.UTC); err != nil || !pt.Equal(m.Time.Time()) {
+			t.Errorf("%q: round trip got %v, %v", c.in, pt, err)
+		}
+	}
+
+	t.Run("Syslog", func(t *testing.T) {
+		m, ok := Find("Jan  2 15:04:05 web01 sshd[4321]: Accepted publickey", time.UTC)
+		if !ok || m.Start != 0 || m.End != 15 || m.Layout != "Jan _2 15:04:05" {
+			t.Fatalf("got %+v, %v", m, ok)
+		}
+		if m.Time.Year() != time.Now().In(time.UTC).Year() || m.Time.Month() != 1 || m.Time.Day() != 2 {
+			t.Errorf("got %s, want current year Jan 2", m.Time)
+		}
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		text := "start 2025-01-02 10:00:00, retry [03/Feb/2025:11:12:13 +0000], done 20250304"
+		ms := FindAll(text, time.UTC)
+		want := []string{"2025-01-02 10:00:00", "2025-02-03 11:12:13", "2025-03-04 00:00:00"}
+		if len(ms) != len(want) {
+			t.Fatalf("FindAll: got %d matches, want %d", len(ms), len(want))
+		}
+		for i, m := range ms {
+			assert(t, m.Time, want[i], "FindAll")
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		for _, in := range []string{
+			"",
+			"no timestamps here, version v1.2.3, pid 12345",
+			"order 12345678 placed",  // 不是有效日期的 8 位数字
+			"x2025-01-02",            // 不在单词边界
+			"2025-13-02 10:00",       // 月份无效
+			"Jan 32 10:00:00 host",   // 日期无效
+			"Mon, 02 Jan 2006 15:04", // 缺少时区
+		} {
+			if m, ok := Find(in); ok {
+				t.Errorf("%q: unexpected match %+v", in, m)
+			}
+		}
+		if ms := FindAll("nothing to see"); ms != nil {
+			t.Errorf("FindAll: got %v, want nil", ms)
+		}
+
+		line := "GET /api/v1/users?id=42 200 0.003s upstream=10.0.0.7:8080 Monday January"
+		if n := testing.AllocsPerRun(100, func() { Find(line) }); n != 0 {
+			t.Errorf("Find allocs: got %v, want 0", n)
+		}
+	})
+}
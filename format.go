@@ -2,6 +2,10 @@ package aeon
 
 import (
 	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"time"
 )
 
@@ -18,7 +22,7 @@ func (t Time) MarshalJSON() ([]byte, error) {
 }
 
 func (t *Time) UnmarshalJSON(b []byte) (err error) {
-	*t, err = ParseE(string(b), t.Location())
+	*t, err = ParseBytesE(b, t.Location())
 	return
 }
 
@@ -34,24 +38,257 @@ func (t *Time) UnmarshalText(data []byte) error {
 }
 
 func (t *Time) Scan(value any) (err error) {
+	*t, err = scan(value, *t)
+	return
+}
+
+func (t Time) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.time, nil
+}
+
+// scan 将数据库驱动返回的值转换为 Time。
+//
+// 不同驱动对 DATETIME/DATE/TIME 列返回的类型并不统一：
+//   - time.Time: 已解析的时间 (如 MySQL parseTime=true)
+//   - []byte, string: 文本形式 (如 MySQL parseTime=false, SQLite)，支持纯日期与纯时间 (日期由 DefaultTimeAnchor 确定，默认为今天)
+//   - int64, float64: 时间戳 (如 SQLite, ClickHouse)，按 Unix 的位数规则推断精度
+//
+// dst 为接收者当前的值：文本按其时区解析，时间戳沿用其时区 (dst 为未设置的零值时使用 DefaultTimeZone，见 unixZone)。
+// MySQL 的零值日期 "0000-00-00" 视为零时，其他类型返回错误。
+func scan(value any, dst Time) (Time, error) {
 	switch v := value.(type) {
+	case nil:
+		return Aeon(), nil
 	case time.Time:
-		*t = Aeon(v)
+		return Aeon(v), nil
+	case []byte:
+		return scanText(btos(v), dst.Location())
 	case string:
-		*t, err = ParseE(v, t.Location())
+		return scanText(v, dst.Location())
+	case int64:
+		return Unix(v, dst.unixZone()), nil
+	case float64:
+		return unixFloat(v, unixDigits(int64(v)), dst.unixZone()), nil
 	default:
-		*t = Aeon()
+		return Time{}, fmt.Errorf("aeon: cannot scan %T into Time", value)
+	}
+}
+
+func scanText(s string, loc *time.Location) (Time, error) {
+	if len(s) >= 10 && s[:10] == "0000-00-00" { // MySQL 零值日期
+		return Aeon(), nil
+	}
+	return ParseE(s, loc)
+}
+
+// unixZone 返回时间戳转换为 Time 时使用的时区：沿用 t 的时区，t 为未设置的零值 (零时且为 UTC) 时使用 DefaultTimeZone。
+func (t Time) unixZone() *time.Location {
+	if loc := t.time.Location(); loc != time.UTC || !t.time.IsZero() {
+		return loc
+	}
+	return DefaultTimeZone
+}
+
+// unixDigits 按 Unix 的位数规则推断时间戳 v 的精度 (0, 3, 6, 9)
+func unixDigits(v int64) int {
+	if v < 0 {
+		v = -v
+	}
+	switch {
+	case v <= 9999999999: // 10位：秒
+		return 0
+	case v <= 9999999999999: // 13位：毫秒
+		return 3
+	case v <= 9999999999999999: // 16位：微秒
+		return 6
 	}
+	return 9
+}
+
+// unixAt 返回精度为 digits (同 Time.Unix 的参数，0 为秒，3 为毫秒，以此类推) 的时间戳 v 在 loc 中的时间
+func unixAt(v int64, digits int, loc *time.Location) Time {
+	d := clamp(digits, 0, 9)
+	return Aeon(time.Unix(v/pow19[d], v%pow19[d]*pow19[9-d]).In(loc))
+}
+
+// unixFloat 返回精度为 digits 的浮点时间戳在 loc 中的时间，小数部分为更细的精度。
+func unixFloat(f float64, digits int, loc *time.Location) Time {
+	secs := int64(f)
+	d := clamp(digits, 0, 9)
+	t := unixAt(secs, d, loc)
+	if d == 9 {
+		return t
+	}
+
+	// float64 仅有约 16 位有效数字，秒与毫秒级的小数部分只保留到微秒。
+	unit, q := float64(pow19[9-d]), 1.0
+	if d <= 3 {
+		q = 1e3
+	}
+	return t.By(time.Duration(math.Round((f-float64(secs))*unit/q) * q))
+}
+
+// --- 二进制编码 ---
+
+const binaryVersion byte = 1
+
+// ErrBinary 表示无法识别的二进制数据
+var ErrBinary = errors.New("aeon: invalid binary data")
+
+// MarshalBinary 实现 encoding.BinaryMarshaler，保留周起始日与时区。
+//
+// 编码布局：版本(1) | 周起始日(1) | 长度(1) | time.Time 二进制 | 时区名称
+//
+// time.Time 的二进制形式只保存偏移量，因此额外写入时区名称，以便解码时还原具名时区。
+func (t Time) MarshalBinary() ([]byte, error) {
+	tb, err := t.time.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	name := t.time.Location().String()
+	b := make([]byte, 0, 3+len(tb)+len(name))
+	b = append(b, binaryVersion, byte(t.weekStarts), byte(len(tb)))
+	b = append(b, tb...)
+	return append(b, name...), nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler。
+//
+// 若时区名称无法加载，或加载后的偏移量与编码时不一致，则还原为同名的固定偏移时区。
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 || data[0] != binaryVersion || data[1] > byte(time.Saturday) {
+		return ErrBinary
+	}
+
+	end := 3 + int(data[2])
+	if len(data) < end {
+		return ErrBinary
+	}
+
+	var tt time.Time
+	if err := tt.UnmarshalBinary(data[3:end]); err != nil {
+		return err
+	}
+
+	*t = Time{time: tt.In(restoreZone(string(data[end:]), tt)), weekStarts: time.Weekday(data[1])}
+	return nil
+}
+
+func (t Time) GobEncode() ([]byte, error)   { return t.MarshalBinary() }
+func (t *Time) GobDecode(data []byte) error { return t.UnmarshalBinary(data) }
+
+// restoreZone 按名称还原 t 的时区
+func restoreZone(name string, t time.Time) *time.Location {
+	_, off := t.Zone()
+	if name == "" { // 匿名固定偏移
+		return NewOffset(off)
+	}
+
+	if loc, err := LoadZone(name); err == nil {
+		if _, o := t.In(loc).Zone(); o == off {
+			return loc
+		}
+	}
+
+	return NewZone(name, off)
+}
+
+// --- 可空时间 ---
+
+// NullTime 表示可为 NULL 的时间。
+//
+// 与 Time 不同，它区分 “显式 NULL” 与零时 (0001-01-01)：
+// Valid 为 false 对应数据库 NULL 与 JSON null，Valid 为 true 时零时也会被原样写出。
+// 文本、二进制与 Gob 编码同样保留 Valid。
+type NullTime struct {
+	Time
+	Valid bool
+}
+
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 0, len(DT)+2)
+	b = append(b, '"')
+	b = n.time.AppendFormat(b, DT)
+	return append(b, '"'), nil
+}
+
+func (n *NullTime) UnmarshalJSON(b []byte) (err error) {
+	s := trim(btos(b))
+	if s == "" || s == "null" {
+		*n = NullTime{}
+		return
+	}
+	n.Time, err = ParseE(s, n.Location())
+	n.Valid = err == nil
 	return
 }
 
-func (t Time) Value() (driver.Value, error) {
-	if t.IsZero() {
+func (n *NullTime) Scan(value any) (err error) {
+	if value == nil {
+		*n = NullTime{}
+		return
+	}
+	n.Time, err = scan(value, n.Time)
+	n.Valid = err == nil
+	return
+}
+
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
 		return nil, nil
 	}
-	return t.time, nil
+	return n.time, nil
+}
+
+func (n NullTime) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte(""), nil
+	}
+	return n.time.AppendFormat(nil, DT), nil
+}
+
+func (n *NullTime) UnmarshalText(data []byte) error {
+	return n.UnmarshalJSON(data)
+}
+
+// MarshalBinary 实现 encoding.BinaryMarshaler，在 Time 的编码前写入 Valid 标志 (1 字节)，NULL 仅编码为该标志。
+func (n NullTime) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{0}, nil
+	}
+	tb, err := n.Time.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{1}, tb...), nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler
+func (n *NullTime) UnmarshalBinary(data []byte) error {
+	switch {
+	case len(data) == 1 && data[0] == 0:
+		*n = NullTime{}
+		return nil
+	case len(data) == 0 || data[0] != 1:
+		return ErrBinary
+	}
+	if err := n.Time.UnmarshalBinary(data[1:]); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
 }
 
+func (n NullTime) GobEncode() ([]byte, error)   { return n.MarshalBinary() }
+func (n *NullTime) GobDecode(data []byte) error { return n.UnmarshalBinary(data) }
+
 // --- 自定义格式器 ---
 
 type Formatter interface {
@@ -82,7 +319,7 @@ func (f F[T]) MarshalJSON() ([]byte, error) {
 }
 
 func (f *F[T]) UnmarshalJSON(b []byte) (err error) {
-	f.Time, err = ParseE(string(b), f.Location())
+	f.Time, err = ParseBytesE(b, f.Location())
 	return
 }
 
@@ -98,14 +335,7 @@ func (f *F[T]) UnmarshalText(data []byte) error {
 }
 
 func (f *F[T]) Scan(value any) (err error) {
-	switch v := value.(type) {
-	case time.Time:
-		f.Time = Aeon(v)
-	case string:
-		f.Time, err = ParseE(v, f.Location())
-	default:
-		f.Time = Aeon()
-	}
+	f.Time, err = scan(value, f.Time)
 	return
 }
 
@@ -123,3 +353,107 @@ type formatDateTimeMilli string
 func (formatDateTimeMilli) Layout() string { return DTMilli }
 
 type DateTimeMilli = F[formatDateTimeMilli]
+
+// --- 时间戳格式器 ---
+
+// ErrTimestamp 表示无法识别的时间戳
+var ErrTimestamp = errors.New("aeon: invalid timestamp")
+
+// Precision 定义时间戳精度，Digits 返回传给 Time.Unix 的精度参数 (0, 3, 6, 9)。
+type Precision interface {
+	Digits() int
+}
+
+// U 以时间戳序列化的时间：JSON 为数字，数据库为 BIGINT。
+//
+// 反序列化时接受数字或带引号的数字字符串，按 T 的精度解析 (与序列化互逆，包括纪元前后的时间)；
+// 结果沿用接收者已有的时区 (如先设置为 Now(loc))，接收者为零值时使用 DefaultTimeZone。
+type U[T Precision] struct {
+	Time
+	p T
+}
+
+func (u U[T]) MarshalJSON() ([]byte, error) {
+	if u.IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(make([]byte, 0, 20), u.Unix(u.p.Digits()), 10), nil
+}
+
+func (u *U[T]) UnmarshalJSON(b []byte) (err error) {
+	u.Time, err = parseUnix(btos(b), u.p.Digits(), u.unixZone())
+	return
+}
+
+func (u U[T]) MarshalText() ([]byte, error) {
+	if u.IsZero() {
+		return []byte(""), nil
+	}
+	return strconv.AppendInt(make([]byte, 0, 20), u.Unix(u.p.Digits()), 10), nil
+}
+
+func (u *U[T]) UnmarshalText(data []byte) error {
+	return u.UnmarshalJSON(data)
+}
+
+func (u *U[T]) Scan(value any) (err error) {
+	switch v := value.(type) {
+	case nil:
+		u.Time = Aeon()
+	case int64:
+		u.Time = unixAt(v, u.p.Digits(), u.unixZone())
+	case float64:
+		u.Time = unixFloat(v, u.p.Digits(), u.unixZone())
+	case []byte:
+		u.Time, err = parseUnix(btos(v), u.p.Digits(), u.unixZone())
+	case string:
+		u.Time, err = parseUnix(v, u.p.Digits(), u.unixZone())
+	case time.Time:
+		u.Time = Aeon(v)
+	default:
+		err = fmt.Errorf("aeon: cannot scan %T into timestamp", value)
+	}
+	return
+}
+
+func (u U[T]) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+	return u.Unix(u.p.Digits()), nil
+}
+
+// parseUnix 解析 (可带引号的) 精度为 digits 的数字时间戳字符串，结果位于 loc
+func parseUnix(s string, digits int, loc *time.Location) (Time, error) {
+	if s = trim(s); s == "" || s == "null" {
+		return Time{}, nil
+	}
+
+	v, ok := atoi(s)
+	if !ok {
+		return Time{}, ErrTimestamp
+	}
+
+	return unixAt(v, digits, loc), nil
+}
+
+// --- 内置的时间戳格式 ---
+
+type (
+	precisionSec   struct{}
+	precisionMilli struct{}
+	precisionMicro struct{}
+	precisionNano  struct{}
+)
+
+func (precisionSec) Digits() int   { return 0 }
+func (precisionMilli) Digits() int { return 3 }
+func (precisionMicro) Digits() int { return 6 }
+func (precisionNano) Digits() int  { return 9 }
+
+type (
+	UnixSec   = U[precisionSec]   // 秒级时间戳
+	UnixMilli = U[precisionMilli] // 毫秒级时间戳
+	UnixMicro = U[precisionMicro] // 微秒级时间戳
+	UnixNano  = U[precisionNano]  // 纳秒级时间戳
+)
@@ -0,0 +1,339 @@
+package aeon
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestUnixFormat(t *testing.T) {
+	type payload struct {
+		Sec   UnixSec   `json:"sec"`
+		Milli UnixMilli `json:"milli"`
+		Nano  UnixNano  `json:"nano"`
+	}
+
+	at := New(2025, 1, 1, 0, 0, 0, 123, UTC)
+	p := payload{Sec: UnixSec{Time: at}, Milli: UnixMilli{Time: at}, Nano: UnixNano{Time: at}}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"sec":1735689600,"milli":1735689600123,"nano":1735689600123000000}`; string(b) != want {
+		t.Errorf("Marshal: got %s, want %s", b, want)
+	}
+
+	var q payload
+	if err = json.Unmarshal([]byte(`{"sec":"1735689600","milli":1735689600123,"nano":null}`), &q); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Sec.Eq(at.StartSecond()) || !q.Milli.Eq(at) || !q.Nano.IsZero() {
+		t.Errorf("Unmarshal: got %v / %v / %v", q.Sec, q.Milli, q.Nano)
+	}
+
+	if err = json.Unmarshal([]byte(`{"sec":"2025-01-01"}`), &q); err == nil {
+		t.Error("Unmarshal: want error for non-numeric timestamp")
+	}
+
+	// 按类型的精度解析，纪元前后的时间也能往返
+	for _, in := range []Time{New(1970, 1, 2, 0, 0, 0, UTC), New(1969, 12, 31, 0, 0, 0, 500, UTC), New(1900, 1, 1, 0, 0, 0, UTC)} {
+		var r payload
+		b, _ := json.Marshal(payload{Sec: UnixSec{Time: in}, Milli: UnixMilli{Time: in}, Nano: UnixNano{Time: in}})
+		if err = json.Unmarshal(b, &r); err != nil || !r.Sec.Eq(in.StartSecond()) || !r.Milli.Eq(in) || !r.Nano.Eq(in) {
+			t.Errorf("往返 %s: got %v / %v / %v, %v", b, r.Sec, r.Milli, r.Nano, err)
+		}
+	}
+	var small UnixMilli
+	if err = small.Scan(int64(86400000)); err != nil || !small.Eq(New(1970, 1, 2, 0, 0, 0, UTC)) {
+		t.Errorf("Scan(int64) 按精度: got %v, %v", small, err)
+	}
+	if err = small.Scan(-1.5); err != nil || small.Unix(6) != -1500 {
+		t.Errorf("Scan(float64) 按精度: got %v, %v", small, err)
+	}
+
+	var u UnixMilli
+	if err = u.Scan(int64(1735689600123)); err != nil || !u.Eq(at) {
+		t.Errorf("Scan(int64): got %v, %v", u, err)
+	}
+	if v, _ := u.Value(); v != int64(1735689600123) {
+		t.Errorf("Value: got %v", v)
+	}
+	if err = u.Scan(true); err == nil {
+		t.Error("Scan(bool): want error")
+	}
+	if err = u.Scan(time.Unix(0, 0)); err != nil || u.Unix() != 0 {
+		t.Errorf("Scan(time.Time): got %v, %v", u, err)
+	}
+
+	// 沿用接收者的时区，零值使用 DefaultTimeZone
+	sh, _ := LoadZone(Shanghai)
+	zoned := UnixMilli{Time: Now(sh)}
+	if err = zoned.Scan(int64(1735689600123)); err != nil || zoned.Location() != sh || !zoned.Eq(at) {
+		t.Errorf("Scan 保留时区: got %v %v, %v", zoned, zoned.Location(), err)
+	}
+	assert(t, zoned.Time, "2025-01-01 08:00:00.123", "Scan 保留时区")
+	zoned = UnixMilli{Time: Now(sh)}
+	if err = json.Unmarshal([]byte(`1735689600123`), &zoned); err != nil || zoned.Location() != sh {
+		t.Errorf("Unmarshal 保留时区: got %v, %v", zoned.Location(), err)
+	}
+	zoned = UnixMilli{Time: Now(sh)}
+	if err = zoned.Scan(1735689600.5); err != nil || zoned.Location() != sh {
+		t.Errorf("Scan(float64) 保留时区: got %v, %v", zoned.Location(), err)
+	}
+	tm := Now(sh)
+	if err = tm.Scan(int64(1735689600)); err != nil || tm.Location() != sh {
+		t.Errorf("Time.Scan 保留时区: got %v, %v", tm.Location(), err)
+	}
+	var fresh UnixMilli
+	if err = fresh.Scan(int64(1735689600123)); err != nil || fresh.Location() != DefaultTimeZone {
+		t.Errorf("零值使用 DefaultTimeZone: got %v, %v", fresh.Location(), err)
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	var n NullTime
+	if err := json.Unmarshal([]byte(`null`), &n); err != nil || n.Valid {
+		t.Errorf("Unmarshal(null): got %+v, %v", n, err)
+	}
+
+	if err := json.Unmarshal([]byte(`"0001-01-01 00:00:00"`), &n); err != nil || !n.Valid || !n.IsZero() {
+		t.Errorf("Unmarshal(zero): got %+v, %v", n, err)
+	}
+	if b, _ := json.Marshal(n); string(b) != `"0001-01-01 00:00:00"` {
+		t.Errorf("Marshal(zero): got %s", b)
+	}
+	if b, _ := json.Marshal(NullTime{}); string(b) != "null" {
+		t.Errorf("Marshal(null): got %s", b)
+	}
+
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil): got %+v, %v", n, err)
+	}
+	if v, _ := n.Value(); v != nil {
+		t.Errorf("Value(null): got %v", v)
+	}
+
+	if err := n.Scan([]byte("2024-05-20 13:14:15")); err != nil || !n.Valid {
+		t.Errorf("Scan([]byte): got %+v, %v", n, err)
+	}
+	assert(t, n.Time, "2024-05-20 13:14:15", "Scan([]byte)")
+
+	if err := n.Scan(int64(1735689600)); err != nil || !n.Valid || n.Unix() != 1735689600 {
+		t.Errorf("Scan(int64): got %+v, %v", n, err)
+	}
+
+	if err := n.Scan(true); err == nil || n.Valid {
+		t.Errorf("Scan(bool): want error, got %+v", n)
+	}
+
+	zero := NullTime{Valid: true}
+	if v, _ := zero.Value(); v != (time.Time{}) {
+		t.Errorf("Value(zero): got %v", v)
+	}
+
+	// Gob、Binary 与 Text 编码保留 Valid
+	for _, in := range []NullTime{{}, zero, {Time: Parse("2024-05-20 13:14:15"), Valid: true}} {
+		var buf bytes.Buffer
+		var g, b, x NullTime
+		if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+			t.Fatal(err)
+		}
+		if err := gob.NewDecoder(&buf).Decode(&g); err != nil || g.Valid != in.Valid || !g.Eq(in.Time) {
+			t.Errorf("Gob(%+v): got %+v, %v", in, g, err)
+		}
+		data, _ := in.MarshalBinary()
+		if err := b.UnmarshalBinary(data); err != nil || b.Valid != in.Valid || !b.Eq(in.Time) {
+			t.Errorf("Binary(%+v): got %+v, %v", in, b, err)
+		}
+		text, _ := in.MarshalText()
+		if err := x.UnmarshalText(text); err != nil || x.Valid != in.Valid || !x.Eq(in.Time) {
+			t.Errorf("Text(%+v): got %+v (%q), %v", in, x, text, err)
+		}
+	}
+	if err := new(NullTime).UnmarshalBinary([]byte{2}); err != ErrBinary {
+		t.Errorf("UnmarshalBinary: got %v, want ErrBinary", err)
+	}
+}
+
+// stubDriver 是一个内存 SQL 驱动，查询语句作为 stubRows 的键，返回单列结果。
+type stubDriver struct{}
+
+type stubConn struct{}
+
+type stubStmt struct{ query string }
+
+type stubRows struct {
+	values []driver.Value
+	i      int
+}
+
+var stubData = map[string][]driver.Value{
+	"mysql":       {time.Date(2024, 5, 20, 13, 14, 15, 0, time.UTC)},
+	"text":        {[]byte("2024-05-20 13:14:15.123456"), "2024-05-20 13:14:15"},
+	"date":        {[]byte("2024-05-20")},
+	"time":        {[]byte("13:14:15"), "08:30"},
+	"zero":        {[]byte("0000-00-00 00:00:00"), nil},
+	"epoch":       {int64(1735689600), int64(1735689600123)},
+	"float":       {1735689600.5, 1735689600123.25},
+	"unsupported": {true},
+}
+
+func (stubDriver) Open(string) (driver.Conn, error)         { return stubConn{}, nil }
+func (stubConn) Prepare(q string) (driver.Stmt, error)      { return stubStmt{q}, nil }
+func (stubConn) Close() error                               { return nil }
+func (stubConn) Begin() (driver.Tx, error)                  { return nil, driver.ErrSkip }
+func (stubStmt) Close() error                               { return nil }
+func (stubStmt) NumInput() int                              { return 0 }
+func (stubStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (s stubStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &stubRows{values: stubData[s.query]}, nil
+}
+func (*stubRows) Columns() []string { return []string{"t"} }
+func (*stubRows) Close() error      { return nil }
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.values) {
+		return io.EOF
+	}
+	dest[0], r.i = r.values[r.i], r.i+1
+	return nil
+}
+
+func init() { sql.Register("aeon-stub", stubDriver{}) }
+
+func TestScanDriver(t *testing.T) {
+	oldLoc := DefaultTimeZone
+	DefaultTimeZone = time.UTC
+	defer func() { DefaultTimeZone = oldLoc }()
+	today := time.Now().UTC().Format("2006-01-02") // TIME 列的日期默认取今天
+
+	db, err := sql.Open("aeon-stub", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	query := func(q string) (res []Time, err error) {
+		rows, err := db.Query(q)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var v Time
+			if err = rows.Scan(&v); err != nil {
+				return nil, err
+			}
+			res = append(res, v)
+		}
+		return res, rows.Err()
+	}
+
+	cases := []struct {
+		query string
+		want  []string
+	}{
+		{"mysql", []string{"2024-05-20 13:14:15"}},
+		{"text", []string{"2024-05-20 13:14:15.123456", "2024-05-20 13:14:15"}},
+		{"date", []string{"2024-05-20 00:00:00"}},
+		{"time", []string{today + " 13:14:15", today + " 08:30:00"}},
+		{"zero", []string{"0001-01-01 00:00:00", "0001-01-01 00:00:00"}},
+		{"epoch", []string{"2025-01-01 00:00:00", "2025-01-01 00:00:00.123"}},
+		{"float", []string{"2025-01-01 00:00:00.5", "2025-01-01 00:00:00.12325"}},
+	}
+
+	for _, c := range cases {
+		got, err := query(c.query)
+		if err != nil {
+			t.Errorf("%s: %v", c.query, err)
+			continue
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("%s: got %d rows, want %d", c.query, len(got), len(c.want))
+			continue
+		}
+		for i, v := range got {
+			assert(t, v, c.want[i], c.query)
+		}
+	}
+
+	if _, err = query("unsupported"); err == nil {
+		t.Error("unsupported: want error")
+	}
+
+	// NULL 与 MySQL 零值日期同样带有 DefaultWeekStarts
+	if zero, _ := query("zero"); len(zero) != 2 || zero[0].weekStarts != DefaultWeekStarts || zero[1].weekStarts != DefaultWeekStarts {
+		t.Errorf("zero: got %+v", zero)
+	}
+
+	rows, _ := db.Query("text")
+	defer rows.Close()
+	for rows.Next() {
+		var v DateTimeMilli
+		if err = rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		if b, _ := v.MarshalText(); string(b) != "2024-05-20 13:14:15.123" {
+			t.Errorf("F[T].Scan: got %s", b)
+		}
+		break
+	}
+}
+
+func TestBinary(t *testing.T) {
+	shanghai, err := LoadZone(Shanghai)
+	if err != nil {
+		t.Skip(err)
+	}
+
+	cases := []Time{
+		New(2024, 5, 20, 13, 14, 15, 123456789, Shanghai).WithWeekStarts(time.Sunday),
+		New(2024, 5, 20, 13, 14, 15).To(NewOffset(5*3600 + 45*60)),
+		New(2024, 5, 20, 13, 14, 15).To(NewZone("CST", 8*3600)).WithWeekStarts(time.Saturday),
+		New(2024, 5, 20, 13, 14, 15).UTC(),
+		{},
+	}
+
+	for _, want := range cases {
+		b, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Time
+		if err = got.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+
+		if got.String() != want.String() || !got.Eq(want) || got.weekStarts != want.weekStarts ||
+			got.Location().String() != want.Location().String() {
+			t.Errorf("Binary: got %v %v %v, want %v %v %v",
+				got, got.Location(), got.weekStarts, want, want.Location(), want.weekStarts)
+		}
+	}
+
+	type item struct{ At Time }
+	var buf bytes.Buffer
+	in := item{At: New(2024, 3, 10, 8, 0, 0).To(shanghai).WithWeekStarts(time.Sunday)}
+	if err = gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+
+	var out item
+	if err = gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.At.Eq(in.At) || out.At.Location() != shanghai || out.At.weekStarts != time.Sunday {
+		t.Errorf("Gob: got %v %v %v", out.At, out.At.Location(), out.At.weekStarts)
+	}
+
+	if err = out.At.UnmarshalBinary([]byte{9, 1}); err == nil {
+		t.Error("UnmarshalBinary: want error for invalid data")
+	}
+}
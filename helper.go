@@ -90,6 +90,24 @@ func dateToAbsDays(year int64, month time.Month, day int) uint64 {
     return centurydays + uint64(int64(cday+ayday)+int64(day)-1)
 }
 
+// absDaysToDate 是 dateToAbsDays 的逆运算，从绝对纪元起的天数返回年月日 (参考标准库实现)
+func absDaysToDate(days uint64) (year int, month time.Month, day int) {
+    d := 4*days + 3
+    century := d / 146097
+    cd := uint32(d%146097) | 3
+    cyear, ayday := cd/1461, cd%1461/4 // 以 3 月 1 日为年初的年份与年内天数
+
+    md := 2141*ayday + 197913
+    janFeb := uint32(0)
+    if ayday >= 306 { // 1 月或 2 月
+        janFeb = 1
+    }
+
+    year = int(century*100-absoluteYears) + int(cyear+janFeb)
+    month = time.Month(md>>16 - janFeb*12)
+    return year, month, 1 + int((md&0xFFFF)/2141)
+}
+
 // weekday 返回指定年月日的周几 (参考标准库实现)
 func weekday(y int, m int, d int) time.Weekday {
     days := dateToAbsDays(int64(y), time.Month(m), d)
@@ -203,6 +221,13 @@ func isDigit4(s string) bool {
     return (v&0xF0F0F0F0 == 0x30303030) && ((v+0x06060606)&0xF0F0F0F0 == 0x30303030)
 }
 
+// btos 以零拷贝方式将字节切片视为字符串。
+//
+// 调用方必须保证在返回的字符串仍被使用期间不修改 b，且结果不会被长期持有。
+func btos(b []byte) string {
+    return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
 // isSep2 判定两个位置是否都不是数字且不是小数点
 func isSep2(c1, c2 byte) bool {
     return (bt[c1]|bt[c2])&kNotSep == 0
@@ -242,3 +267,35 @@ func clamp[T ~int | ~float32 | ~float64](value, minimum, maximum T) T {
     }
     return value
 }
+
+// atoi 解析十进制整数 (允许前导符号)，不分配内存。
+//
+// 超过 19 位或包含非数字字符时返回 ok=false。
+func atoi(s string) (v int64, ok bool) {
+    neg := false
+    if len(s) > 0 && bt[s[0]]&kSign != 0 {
+        neg, s = s[0] == '-', s[1:]
+    }
+
+    if n := len(s); n == 0 || n > 19 {
+        return 0, false
+    }
+
+    for i := 0; i < len(s); i++ {
+        d := s[i] - '0'
+        if d > 9 {
+            return 0, false
+        }
+        v = v*10 + int64(d)
+    }
+
+    if v < 0 { // 19 位溢出
+        return 0, false
+    }
+
+    if neg {
+        v = -v
+    }
+
+    return v, true
+}
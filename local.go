@@ -0,0 +1,354 @@
+package aeon
+
+import (
+    "database/sql/driver"
+    "time"
+)
+
+// LocalDateTime 不含时区的日期与钟面时间，即用户输入的 "某地某日几点"，如会议时间 2025-03-09 02:30。
+//
+// 提前按时区换算为时刻会丢失信息 (夏令时跳过或重复的钟面时间)，LocalDateTime 保留原始的字段，
+// 需要时刻时使用 In 显式指定时区与 DSTPolicy。
+//
+// 零值 (日期为零值) 表示无时间 (不同于 0001-01-01 00:00)，其级联方法返回零值，格式化结果为空字符串。
+// LocalDateTime 可以用 == 比较。级联方法与 Time 的同名方法一致，按钟面时间运算 (不受夏令时影响)。
+type LocalDateTime struct {
+    date  Date
+    clock Clock
+}
+
+// NewLocalDateTime 返回指定年月日时分秒的本地时间，超出范围的字段按 time.Date 的规则进位
+func NewLocalDateTime(y, m, d, h, mm, s int, ns ...int) LocalDateTime {
+    n := 0
+    if len(ns) > 0 {
+        n = ns[0]
+    }
+    return localOf(time.Date(y, time.Month(m), d, h, mm, s, n, time.UTC))
+}
+
+// LocalOf 组合日期与钟面时间
+func LocalOf(d Date, c Clock) LocalDateTime {
+    return LocalDateTime{d, c}
+}
+
+// ToLocal 返回 t 在其所在时区的日期与钟面时间 (零时为 0001-01-01 00:00)
+func (t Time) ToLocal() LocalDateTime {
+    return localOf(t.time)
+}
+
+// ParseLocalE 解析时间字符串，返回字符串中书写的日期与钟面时间。
+//
+// 支持 ParseE 的所有格式；字符串中的 UTC 偏移与时区名称 (如 "[Asia/Shanghai]"、"America/New_York"、"EST") 被忽略，
+// 钟面时间保持书写的值，如 "2025-03-09 02:30 America/New_York" 为 2025-03-09 02:30 (即使该时间在纽约被夏令时跳过)。
+// 无法识别的 IANA 名称或方括号后缀返回 ErrZone。
+func ParseLocalE(s string) (LocalDateTime, error) {
+    t, err := defaultParser.parseLocal(s)
+    if err != nil || isNull(s, t) {
+        return LocalDateTime{}, err
+    }
+    return localOf(t), nil
+}
+
+// ParseLocal 解析时间字符串，忽略错误
+func ParseLocal(s string) LocalDateTime {
+    l, _ := ParseLocalE(s)
+    return l
+}
+
+// parseLocal 解析 s 中书写的钟面时间：去除末尾的时区名称且不在该时区中解析，偏移只用于去除，结果的钟面时间与书写的一致
+func (p *Parser) parseLocal(s string) (time.Time, error) {
+    if s = trim(s); s == "" || s == "null" {
+        return time.Time{}, nil
+    }
+
+    o := &p.ParseOptions
+    if s[0] == '@' || o.Epoch && isEpoch(s) {
+        return o.parse(s, time.UTC)
+    }
+
+    s, _, err := o.zoneSuffix(s)
+    if err != nil {
+        return time.Time{}, err
+    }
+    return o.parseZone(s, time.UTC, zoneName{})
+}
+
+func localOf(t time.Time) LocalDateTime {
+    h, m, s := t.Clock()
+    return LocalDateTime{dateOf(t), NewClock(h, m, s, t.Nanosecond())}
+}
+
+// utc 返回钟面时间相同的 UTC 时间，用于复用级联引擎 (UTC 没有夏令时，按钟面时间运算)
+func (l LocalDateTime) utc() Time {
+    return Time{time: l.date.At(l.clock, time.UTC).time, weekStarts: DefaultWeekStarts}
+}
+
+// In 返回本地时间在 loc 中的时刻。
+//
+// 钟面时间落在夏令时跳过或重复的时段时按 policy 处理，如 GapForward|FoldEarlier (零值)；
+// 使用 GapReject、FoldReject 时返回 ErrNonexistent、ErrAmbiguous。l 为零时返回零时。
+func (l LocalDateTime) In(loc *time.Location, policy DSTPolicy) (Time, error) {
+    if l.IsZero() {
+        return Aeon(), nil
+    }
+    h, mm, s := l.clock.Clock()
+    t, err := resolve(l.date.year, int(l.date.month), l.date.day, h, mm, s, l.clock.Nanosecond(), loc, policy, time.Time{}, false)
+    return Aeon(t), err
+}
+
+// --- 获取时间 ---
+
+func (l LocalDateTime) ToDate() Date          { return l.date }
+func (l LocalDateTime) ToClock() Clock        { return l.clock }
+func (l LocalDateTime) Year() int             { return l.date.year }
+func (l LocalDateTime) Month() int            { return int(l.date.month) }
+func (l LocalDateTime) Day() int              { return l.date.day }
+func (l LocalDateTime) Hour() int             { return l.clock.Hour() }
+func (l LocalDateTime) Minute() int           { return l.clock.Minute() }
+func (l LocalDateTime) Second() int           { return l.clock.Second() }
+func (l LocalDateTime) Nanosecond() int       { return l.clock.Nanosecond() }
+func (l LocalDateTime) Date() (int, int, int) { return l.date.Date() }
+func (l LocalDateTime) Clock() (h, mm, s int) { return l.clock.Clock() }
+func (l LocalDateTime) Weekday() time.Weekday { return l.date.Weekday() }
+func (l LocalDateTime) IsZero() bool          { return l.date.IsZero() }
+
+// --- 时间运算 (按钟面时间，一天总是 24 小时) ---
+
+// Add 返回 l 加上 d 后的本地时间，零值返回零值
+func (l LocalDateTime) Add(d time.Duration) LocalDateTime {
+    if l.IsZero() {
+        return l
+    }
+    return localOf(l.utc().time.Add(d))
+}
+
+// Sub 返回 l 与 u 的钟面时间之差 (l - u)，任一为零值时返回 0
+func (l LocalDateTime) Sub(u LocalDateTime) time.Duration {
+    if l.IsZero() || u.IsZero() {
+        return 0
+    }
+    return l.utc().time.Sub(u.utc().time)
+}
+
+// Compare 比较 l 与 u：l 早于 u 返回 -1，晚于 u 返回 +1，相同返回 0。零值早于所有时间。
+func (l LocalDateTime) Compare(u LocalDateTime) int {
+    if c := l.date.Compare(u.date); c != 0 {
+        return c
+    }
+    return l.clock.Compare(u.clock)
+}
+
+func (l LocalDateTime) Lt(u LocalDateTime) bool { return l.Compare(u) < 0 }
+func (l LocalDateTime) Gt(u LocalDateTime) bool { return l.Compare(u) > 0 }
+
+// Between 判断 l 是否在 [start, end] 之间 (包含两端)
+func (l LocalDateTime) Between(start, end LocalDateTime) bool {
+    return !l.Lt(start) && !l.Gt(end)
+}
+
+// --- 级联导航 (参数含义与 Time 的同名方法一致) ---
+
+// via 在钟面时间相同的 UTC 时间上调用 Time 的级联方法 f，零值返回零值
+func (l LocalDateTime) via(f func(Time, ...int) Time, n []int) LocalDateTime {
+    if l.IsZero() {
+        return l
+    }
+    return f(l.utc(), n...).ToLocal()
+}
+
+func (l LocalDateTime) StartYear(n ...int) LocalDateTime    { return l.via(Time.StartYear, n) }
+func (l LocalDateTime) StartQuarter(n ...int) LocalDateTime { return l.via(Time.StartQuarter, n) }
+func (l LocalDateTime) StartMonth(n ...int) LocalDateTime   { return l.via(Time.StartMonth, n) }
+func (l LocalDateTime) StartWeek(n ...int) LocalDateTime    { return l.via(Time.StartWeek, n) }
+func (l LocalDateTime) StartWeekday(n ...int) LocalDateTime { return l.via(Time.StartWeekday, n) }
+func (l LocalDateTime) StartDay(n ...int) LocalDateTime     { return l.via(Time.StartDay, n) }
+func (l LocalDateTime) StartHour(n ...int) LocalDateTime    { return l.via(Time.StartHour, n) }
+func (l LocalDateTime) StartMinute(n ...int) LocalDateTime  { return l.via(Time.StartMinute, n) }
+func (l LocalDateTime) StartSecond(n ...int) LocalDateTime  { return l.via(Time.StartSecond, n) }
+
+func (l LocalDateTime) EndYear(n ...int) LocalDateTime    { return l.via(Time.EndYear, n) }
+func (l LocalDateTime) EndQuarter(n ...int) LocalDateTime { return l.via(Time.EndQuarter, n) }
+func (l LocalDateTime) EndMonth(n ...int) LocalDateTime   { return l.via(Time.EndMonth, n) }
+func (l LocalDateTime) EndWeek(n ...int) LocalDateTime    { return l.via(Time.EndWeek, n) }
+func (l LocalDateTime) EndWeekday(n ...int) LocalDateTime { return l.via(Time.EndWeekday, n) }
+func (l LocalDateTime) EndDay(n ...int) LocalDateTime     { return l.via(Time.EndDay, n) }
+func (l LocalDateTime) EndHour(n ...int) LocalDateTime    { return l.via(Time.EndHour, n) }
+func (l LocalDateTime) EndMinute(n ...int) LocalDateTime  { return l.via(Time.EndMinute, n) }
+func (l LocalDateTime) EndSecond(n ...int) LocalDateTime  { return l.via(Time.EndSecond, n) }
+
+func (l LocalDateTime) StartByYear(n ...int) LocalDateTime    { return l.via(Time.StartByYear, n) }
+func (l LocalDateTime) StartByQuarter(n ...int) LocalDateTime { return l.via(Time.StartByQuarter, n) }
+func (l LocalDateTime) StartByMonth(n ...int) LocalDateTime   { return l.via(Time.StartByMonth, n) }
+func (l LocalDateTime) StartByWeek(n ...int) LocalDateTime    { return l.via(Time.StartByWeek, n) }
+func (l LocalDateTime) StartByWeekday(n ...int) LocalDateTime { return l.via(Time.StartByWeekday, n) }
+func (l LocalDateTime) StartByDay(n ...int) LocalDateTime     { return l.via(Time.StartByDay, n) }
+func (l LocalDateTime) StartByHour(n ...int) LocalDateTime    { return l.via(Time.StartByHour, n) }
+func (l LocalDateTime) StartByMinute(n ...int) LocalDateTime  { return l.via(Time.StartByMinute, n) }
+func (l LocalDateTime) StartBySecond(n ...int) LocalDateTime  { return l.via(Time.StartBySecond, n) }
+
+func (l LocalDateTime) EndByYear(n ...int) LocalDateTime    { return l.via(Time.EndByYear, n) }
+func (l LocalDateTime) EndByQuarter(n ...int) LocalDateTime { return l.via(Time.EndByQuarter, n) }
+func (l LocalDateTime) EndByMonth(n ...int) LocalDateTime   { return l.via(Time.EndByMonth, n) }
+func (l LocalDateTime) EndByWeek(n ...int) LocalDateTime    { return l.via(Time.EndByWeek, n) }
+func (l LocalDateTime) EndByWeekday(n ...int) LocalDateTime { return l.via(Time.EndByWeekday, n) }
+func (l LocalDateTime) EndByDay(n ...int) LocalDateTime     { return l.via(Time.EndByDay, n) }
+func (l LocalDateTime) EndByHour(n ...int) LocalDateTime    { return l.via(Time.EndByHour, n) }
+func (l LocalDateTime) EndByMinute(n ...int) LocalDateTime  { return l.via(Time.EndByMinute, n) }
+func (l LocalDateTime) EndBySecond(n ...int) LocalDateTime  { return l.via(Time.EndBySecond, n) }
+
+func (l LocalDateTime) StartAtYear(n ...int) LocalDateTime    { return l.via(Time.StartAtYear, n) }
+func (l LocalDateTime) StartAtQuarter(n ...int) LocalDateTime { return l.via(Time.StartAtQuarter, n) }
+func (l LocalDateTime) StartAtMonth(n ...int) LocalDateTime   { return l.via(Time.StartAtMonth, n) }
+func (l LocalDateTime) StartAtWeek(n ...int) LocalDateTime    { return l.via(Time.StartAtWeek, n) }
+func (l LocalDateTime) StartAtWeekday(n ...int) LocalDateTime { return l.via(Time.StartAtWeekday, n) }
+func (l LocalDateTime) StartAtDay(n ...int) LocalDateTime     { return l.via(Time.StartAtDay, n) }
+func (l LocalDateTime) StartAtHour(n ...int) LocalDateTime    { return l.via(Time.StartAtHour, n) }
+func (l LocalDateTime) StartAtMinute(n ...int) LocalDateTime  { return l.via(Time.StartAtMinute, n) }
+func (l LocalDateTime) StartAtSecond(n ...int) LocalDateTime  { return l.via(Time.StartAtSecond, n) }
+
+func (l LocalDateTime) EndAtYear(n ...int) LocalDateTime    { return l.via(Time.EndAtYear, n) }
+func (l LocalDateTime) EndAtQuarter(n ...int) LocalDateTime { return l.via(Time.EndAtQuarter, n) }
+func (l LocalDateTime) EndAtMonth(n ...int) LocalDateTime   { return l.via(Time.EndAtMonth, n) }
+func (l LocalDateTime) EndAtWeek(n ...int) LocalDateTime    { return l.via(Time.EndAtWeek, n) }
+func (l LocalDateTime) EndAtWeekday(n ...int) LocalDateTime { return l.via(Time.EndAtWeekday, n) }
+func (l LocalDateTime) EndAtDay(n ...int) LocalDateTime     { return l.via(Time.EndAtDay, n) }
+func (l LocalDateTime) EndAtHour(n ...int) LocalDateTime    { return l.via(Time.EndAtHour, n) }
+func (l LocalDateTime) EndAtMinute(n ...int) LocalDateTime  { return l.via(Time.EndAtMinute, n) }
+func (l LocalDateTime) EndAtSecond(n ...int) LocalDateTime  { return l.via(Time.EndAtSecond, n) }
+
+func (l LocalDateTime) StartInYear(n ...int) LocalDateTime    { return l.via(Time.StartInYear, n) }
+func (l LocalDateTime) StartInQuarter(n ...int) LocalDateTime { return l.via(Time.StartInQuarter, n) }
+func (l LocalDateTime) StartInMonth(n ...int) LocalDateTime   { return l.via(Time.StartInMonth, n) }
+func (l LocalDateTime) StartInWeek(n ...int) LocalDateTime    { return l.via(Time.StartInWeek, n) }
+func (l LocalDateTime) StartInWeekday(n ...int) LocalDateTime { return l.via(Time.StartInWeekday, n) }
+func (l LocalDateTime) StartInDay(n ...int) LocalDateTime     { return l.via(Time.StartInDay, n) }
+func (l LocalDateTime) StartInHour(n ...int) LocalDateTime    { return l.via(Time.StartInHour, n) }
+func (l LocalDateTime) StartInMinute(n ...int) LocalDateTime  { return l.via(Time.StartInMinute, n) }
+func (l LocalDateTime) StartInSecond(n ...int) LocalDateTime  { return l.via(Time.StartInSecond, n) }
+
+func (l LocalDateTime) EndInYear(n ...int) LocalDateTime    { return l.via(Time.EndInYear, n) }
+func (l LocalDateTime) EndInQuarter(n ...int) LocalDateTime { return l.via(Time.EndInQuarter, n) }
+func (l LocalDateTime) EndInMonth(n ...int) LocalDateTime   { return l.via(Time.EndInMonth, n) }
+func (l LocalDateTime) EndInWeek(n ...int) LocalDateTime    { return l.via(Time.EndInWeek, n) }
+func (l LocalDateTime) EndInWeekday(n ...int) LocalDateTime { return l.via(Time.EndInWeekday, n) }
+func (l LocalDateTime) EndInDay(n ...int) LocalDateTime     { return l.via(Time.EndInDay, n) }
+func (l LocalDateTime) EndInHour(n ...int) LocalDateTime    { return l.via(Time.EndInHour, n) }
+func (l LocalDateTime) EndInMinute(n ...int) LocalDateTime  { return l.via(Time.EndInMinute, n) }
+func (l LocalDateTime) EndInSecond(n ...int) LocalDateTime  { return l.via(Time.EndInSecond, n) }
+
+func (l LocalDateTime) GoYear(n ...int) LocalDateTime    { return l.via(Time.GoYear, n) }
+func (l LocalDateTime) GoQuarter(n ...int) LocalDateTime { return l.via(Time.GoQuarter, n) }
+func (l LocalDateTime) GoMonth(n ...int) LocalDateTime   { return l.via(Time.GoMonth, n) }
+func (l LocalDateTime) GoWeek(n ...int) LocalDateTime    { return l.via(Time.GoWeek, n) }
+func (l LocalDateTime) GoWeekday(n ...int) LocalDateTime { return l.via(Time.GoWeekday, n) }
+func (l LocalDateTime) GoDay(n ...int) LocalDateTime     { return l.via(Time.GoDay, n) }
+func (l LocalDateTime) GoHour(n ...int) LocalDateTime    { return l.via(Time.GoHour, n) }
+func (l LocalDateTime) GoMinute(n ...int) LocalDateTime  { return l.via(Time.GoMinute, n) }
+func (l LocalDateTime) GoSecond(n ...int) LocalDateTime  { return l.via(Time.GoSecond, n) }
+
+func (l LocalDateTime) AtYear(n ...int) LocalDateTime    { return l.via(Time.AtYear, n) }
+func (l LocalDateTime) AtQuarter(n ...int) LocalDateTime { return l.via(Time.AtQuarter, n) }
+func (l LocalDateTime) AtMonth(n ...int) LocalDateTime   { return l.via(Time.AtMonth, n) }
+func (l LocalDateTime) AtWeek(n ...int) LocalDateTime    { return l.via(Time.AtWeek, n) }
+func (l LocalDateTime) AtWeekday(n ...int) LocalDateTime { return l.via(Time.AtWeekday, n) }
+func (l LocalDateTime) AtDay(n ...int) LocalDateTime     { return l.via(Time.AtDay, n) }
+func (l LocalDateTime) AtHour(n ...int) LocalDateTime    { return l.via(Time.AtHour, n) }
+func (l LocalDateTime) AtMinute(n ...int) LocalDateTime  { return l.via(Time.AtMinute, n) }
+func (l LocalDateTime) AtSecond(n ...int) LocalDateTime  { return l.via(Time.AtSecond, n) }
+
+func (l LocalDateTime) InYear(n ...int) LocalDateTime    { return l.via(Time.InYear, n) }
+func (l LocalDateTime) InQuarter(n ...int) LocalDateTime { return l.via(Time.InQuarter, n) }
+func (l LocalDateTime) InMonth(n ...int) LocalDateTime   { return l.via(Time.InMonth, n) }
+func (l LocalDateTime) InWeek(n ...int) LocalDateTime    { return l.via(Time.InWeek, n) }
+func (l LocalDateTime) InDay(n ...int) LocalDateTime     { return l.via(Time.InDay, n) }
+func (l LocalDateTime) InHour(n ...int) LocalDateTime    { return l.via(Time.InHour, n) }
+func (l LocalDateTime) InMinute(n ...int) LocalDateTime  { return l.via(Time.InMinute, n) }
+func (l LocalDateTime) InSecond(n ...int) LocalDateTime  { return l.via(Time.InSecond, n) }
+
+func (l LocalDateTime) ByYear(n ...int) LocalDateTime    { return l.via(Time.ByYear, n) }
+func (l LocalDateTime) ByQuarter(n ...int) LocalDateTime { return l.via(Time.ByQuarter, n) }
+func (l LocalDateTime) ByMonth(n ...int) LocalDateTime   { return l.via(Time.ByMonth, n) }
+func (l LocalDateTime) ByWeek(n ...int) LocalDateTime    { return l.via(Time.ByWeek, n) }
+func (l LocalDateTime) ByDay(n ...int) LocalDateTime     { return l.via(Time.ByDay, n) }
+func (l LocalDateTime) ByHour(n ...int) LocalDateTime    { return l.via(Time.ByHour, n) }
+func (l LocalDateTime) ByMinute(n ...int) LocalDateTime  { return l.via(Time.ByMinute, n) }
+func (l LocalDateTime) BySecond(n ...int) LocalDateTime  { return l.via(Time.BySecond, n) }
+
+// --- 格式化与序列化 ---
+
+// Format 按布局格式化本地时间，零值返回空字符串
+func (l LocalDateTime) Format(layout string) string {
+    if l.IsZero() {
+        return ""
+    }
+    return l.utc().Format(layout)
+}
+
+// String 返回 "2006-01-02 15:04:05"，有小数秒时带上小数部分；零值返回空字符串
+func (l LocalDateTime) String() string { return l.Format(DTNs) }
+
+func (l LocalDateTime) AppendFormat(b []byte, layout string) []byte {
+    if l.IsZero() {
+        return b
+    }
+    return l.utc().AppendFormat(b, layout)
+}
+
+func (l LocalDateTime) MarshalJSON() ([]byte, error) {
+    if l.IsZero() {
+        return []byte("null"), nil
+    }
+    b := make([]byte, 0, len(DTNs)+2)
+    b = append(b, '"')
+    b = l.AppendFormat(b, DTNs)
+    return append(b, '"'), nil
+}
+
+// UnmarshalJSON 解析 JSON 字符串，null 或空字符串为零值
+func (l *LocalDateTime) UnmarshalJSON(b []byte) (err error) {
+    *l, err = ParseLocalE(btos(b))
+    return
+}
+
+func (l LocalDateTime) MarshalText() ([]byte, error) {
+    if l.IsZero() {
+        return []byte(""), nil
+    }
+    return l.AppendFormat(nil, DTNs), nil
+}
+
+func (l *LocalDateTime) UnmarshalText(data []byte) error {
+    return l.UnmarshalJSON(data)
+}
+
+// Scan 实现 sql.Scanner，支持 DATETIME 列返回的 time.Time (取其所在时区的钟面时间)、文本 (同 ParseLocalE) 与时间戳
+func (l *LocalDateTime) Scan(value any) (err error) {
+    var s string
+    switch v := value.(type) {
+    case []byte:
+        s = btos(v)
+    case string:
+        s = v
+    default:
+        t, err := scan(value, Time{})
+        if *l = t.ToLocal(); err != nil || isNull(value, t.time) {
+            *l = LocalDateTime{}
+        }
+        return err
+    }
+
+    if len(s) >= 10 && s[:10] == "0000-00-00" { // MySQL 零值日期
+        *l = LocalDateTime{}
+        return nil
+    }
+    *l, err = ParseLocalE(s)
+    return
+}
+
+// Value 实现 driver.Valuer，以 "2006-01-02 15:04:05" 文本写入 (有小数秒时带上小数部分)，避免驱动按时区转换
+func (l LocalDateTime) Value() (driver.Value, error) {
+    if l.IsZero() {
+        return nil, nil
+    }
+    return l.String(), nil
+}
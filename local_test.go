@@ -0,0 +1,196 @@
+package aeon
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocalDateTime(t *testing.T) {
+	meeting := NewLocalDateTime(2025, 3, 9, 2, 30, 0)
+	if meeting.String() != "2025-03-09 02:30:00" || meeting.ToDate() != NewDate(2025, 3, 9) || meeting.ToClock() != NewClock(2, 30, 0) {
+		t.Fatalf("NewLocalDateTime: got %s", meeting)
+	}
+	if got := NewLocalDateTime(2025, 12, 31, 23, 59, 60); got != LocalOf(NewDate(2026, 1, 1), Clock{}) {
+		t.Errorf("NewLocalDateTime 进位: got %s", got)
+	}
+
+	t.Run("In", func(t *testing.T) {
+		ny, err := LoadZone(NewYork)
+		if err != nil {
+			t.Skip(err)
+		}
+		berlin, _ := LoadZone("Europe/Berlin")
+
+		cases := []struct {
+			name   string
+			local  LocalDateTime
+			loc    *time.Location
+			policy DSTPolicy
+			want   string
+			offset int
+			err    error
+		}{
+			{"NY 跳过/顺延", meeting, ny, GapForward, "2025-03-09 03:30:00", -4 * 3600, nil},
+			{"NY 跳过/提前", meeting, ny, GapBackward, "2025-03-09 01:30:00", -5 * 3600, nil},
+			{"NY 跳过/拒绝", meeting, ny, GapReject, "", 0, ErrNonexistent},
+			{"NY 重复/较早", NewLocalDateTime(2025, 11, 2, 1, 30, 0), ny, FoldEarlier, "2025-11-02 01:30:00", -4 * 3600, nil},
+			{"NY 重复/较晚", NewLocalDateTime(2025, 11, 2, 1, 30, 0), ny, FoldLater, "2025-11-02 01:30:00", -5 * 3600, nil},
+			{"NY 重复/拒绝", NewLocalDateTime(2025, 11, 2, 1, 30, 0), ny, GapReject | FoldReject, "", 0, ErrAmbiguous},
+			{"Berlin 跳过/顺延", NewLocalDateTime(2025, 3, 30, 2, 30, 0), berlin, GapForward, "2025-03-30 03:30:00", 2 * 3600, nil},
+			{"Berlin 跳过/提前", NewLocalDateTime(2025, 3, 30, 2, 30, 0), berlin, GapBackward | FoldLater, "2025-03-30 01:30:00", 3600, nil},
+			{"Berlin 重复/较早", NewLocalDateTime(2025, 10, 26, 2, 30, 0), berlin, FoldEarlier, "2025-10-26 02:30:00", 2 * 3600, nil},
+			{"Berlin 重复/较晚", NewLocalDateTime(2025, 10, 26, 2, 30, 0), berlin, GapReject | FoldLater, "2025-10-26 02:30:00", 3600, nil},
+			{"普通时间", NewLocalDateTime(2025, 6, 1, 12, 0, 0), ny, GapReject | FoldReject, "2025-06-01 12:00:00", -4 * 3600, nil},
+			{"固定偏移", meeting, NewOffset(8 * 3600), GapReject | FoldReject, "2025-03-09 02:30:00", 8 * 3600, nil},
+		}
+		for _, c := range cases {
+			got, err := c.local.In(c.loc, c.policy)
+			if !errors.Is(err, c.err) {
+				t.Errorf("%s: got err %v, want %v", c.name, err, c.err)
+				continue
+			}
+			if c.err != nil {
+				continue
+			}
+			assert(t, got, c.want, c.name)
+			assertZone(t, got, c.offset, c.name)
+			if got.ToLocal() != c.local && c.policy&gapMask == GapReject {
+				t.Errorf("%s: ToLocal got %s", c.name, got.ToLocal())
+			}
+		}
+
+		if got, err := (LocalDateTime{}).In(ny, 0); err != nil || !got.IsZero() {
+			t.Errorf("zero: got %s, %v", got, err)
+		}
+	})
+
+	t.Run("Cascade", func(t *testing.T) {
+		ref := NewLocalDateTime(2025, 3, 5, 14, 20, 30)
+		cases := []struct {
+			name string
+			got  LocalDateTime
+			want string
+		}{
+			{"StartDay", ref.StartDay(), "2025-03-05 00:00:00"},
+			{"EndDay", ref.EndDay(), "2025-03-05 23:59:59.999999999"},
+			{"StartMonth", ref.StartMonth(), "2025-03-01 00:00:00"},
+			{"EndWeek", ref.EndWeek(), "2025-03-09 23:59:59.999999999"},
+			{"StartByHour", ref.StartByHour(), "2025-03-05 14:00:00"},
+			{"ByHour(12)", ref.ByHour(12), "2025-03-06 02:20:30"},
+			{"ByMonth(-1)", ref.ByMonth(-1), "2025-02-05 14:20:30"},
+			{"GoDay(9, 2)", ref.GoDay(9, 2), "2025-03-09 02:20:30"},
+			{"StartInDay(4, 2)", ref.StartInDay(4, 2), "2025-03-09 02:00:00"},
+		}
+		for _, c := range cases {
+			if c.got.String() != c.want {
+				t.Errorf("%s: got %s, want %s", c.name, c.got, c.want)
+			}
+		}
+
+		if d := ref.ByDay(1).Sub(ref); d != 24*time.Hour {
+			t.Errorf("Sub: got %v", d)
+		}
+		if got := ref.Add(10 * time.Hour); got.String() != "2025-03-06 00:20:30" {
+			t.Errorf("Add: got %s", got)
+		}
+		if !ref.Lt(ref.ByMinute(1)) || !ref.Gt(ref.StartDay()) || !ref.Between(ref.StartDay(), ref.EndDay()) {
+			t.Error("Compare/Between")
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		for in, want := range map[string]string{
+			"2025-03-09 02:30":                            "2025-03-09 02:30:00",
+			"2025-03-09T02:30:00-05:00":                   "2025-03-09 02:30:00",
+			"2025-03-09T02:30:00.5Z":                      "2025-03-09 02:30:00.5",
+			"Sun, 09 Mar 2025 02:30:00 EST":               "2025-03-09 02:30:00",
+			"2025年3月9日 下午2点30分":                           "2025-03-09 14:30:00",
+			"2025-03-09 02:30 America/New_York":           "2025-03-09 02:30:00", // 纽约夏令时跳过的时间
+			"2025-03-09 02:30:00[America/New_York]":       "2025-03-09 02:30:00",
+			"2025-03-09T02:30:00-05:00[America/New_York]": "2025-03-09 02:30:00",
+			"2025-03-30 02:30 CET":                        "2025-03-30 02:30:00", // 柏林夏令时跳过的时间
+			"2025-03-09 02:30 UTC+8":                      "2025-03-09 02:30:00",
+		} {
+			if got, err := ParseLocalE(in); err != nil || got.String() != want {
+				t.Errorf("%s: got %s, %v", in, got, err)
+			}
+		}
+		for _, in := range []string{"2025-03-09 02:30 Mars/Olympus", "2025-03-09 02:30[Mars/Olympus]"} {
+			if _, err := ParseLocalE(in); err != ErrZone {
+				t.Errorf("%s: got %v, want ErrZone", in, err)
+			}
+		}
+	})
+
+	t.Run("Serialize", func(t *testing.T) {
+		type event struct {
+			At  LocalDateTime  `json:"at"`
+			End *LocalDateTime `json:"end"`
+		}
+		b, _ := json.Marshal(event{At: meeting})
+		if string(b) != `{"at":"2025-03-09 02:30:00","end":null}` {
+			t.Errorf("Marshal: got %s", b)
+		}
+
+		var e event
+		if err := json.Unmarshal([]byte(`{"at":"2025-03-09T02:30:00-05:00","end":null}`), &e); err != nil {
+			t.Fatal(err)
+		}
+		if e.At != meeting || e.End != nil {
+			t.Errorf("Unmarshal: got %+v", e)
+		}
+
+		var sl LocalDateTime
+		if err := sl.Scan(time.Date(2025, 3, 9, 2, 30, 0, 0, time.UTC)); err != nil || sl != meeting {
+			t.Errorf("Scan time.Time: got %s, %v", sl, err)
+		}
+		if err := sl.Scan([]byte("2025-03-09 02:30:00")); err != nil || sl != meeting {
+			t.Errorf("Scan []byte: got %s, %v", sl, err)
+		}
+		if err := sl.Scan("2025-03-09 02:30:00 America/New_York"); err != nil || sl != meeting { // 纽约夏令时跳过的时间
+			t.Errorf("Scan 时区名称: got %s, %v", sl, err)
+		}
+		if err := json.Unmarshal([]byte(`{"at":"2025-03-09 02:30:00 America/New_York"}`), &e); err != nil || e.At != meeting {
+			t.Errorf("Unmarshal 时区名称: got %s, %v", e.At, err)
+		}
+		if err := sl.UnmarshalText([]byte("2025-03-09T02:30:00[America/New_York]")); err != nil || sl != meeting {
+			t.Errorf("UnmarshalText 时区名称: got %s, %v", sl, err)
+		}
+		if v, _ := meeting.Value(); v != "2025-03-09 02:30:00" {
+			t.Errorf("Value: got %v", v)
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		first := ParseLocal("0001-01-01 00:00:00") // 最早的时间不是零值
+		if first.IsZero() || first.String() != "0001-01-01 00:00:00" {
+			t.Errorf("0001-01-01: got %s, zero %v", first, first.IsZero())
+		}
+		if v, _ := first.Value(); v != "0001-01-01 00:00:00" {
+			t.Errorf("0001-01-01 Value: got %v", v)
+		}
+
+		var zero LocalDateTime
+		if !zero.IsZero() || zero.String() != "" || !zero.StartDay().IsZero() || !zero.Add(time.Hour).IsZero() {
+			t.Errorf("LocalDateTime{}: got %q", zero)
+		}
+		if b, _ := zero.MarshalText(); len(b) != 0 {
+			t.Errorf("LocalDateTime{} MarshalText: got %q", b)
+		}
+		if zero.Sub(first) != 0 || first.Sub(zero) != 0 || zero.Compare(first) != -1 || first.Compare(zero) != 1 {
+			t.Errorf("LocalDateTime{} Sub/Compare: got %v, %d", zero.Sub(first), zero.Compare(first))
+		}
+		if v, _ := zero.Value(); v != nil {
+			t.Errorf("LocalDateTime{} Value: got %v", v)
+		}
+		var sl LocalDateTime
+		if err := sl.Scan(nil); err != nil || !sl.IsZero() {
+			t.Errorf("Scan nil: got %s, %v", sl, err)
+		}
+		if err := sl.UnmarshalJSON([]byte("null")); err != nil || !sl.IsZero() {
+			t.Errorf("null: got %s, %v", sl, err)
+		}
+	})
+}
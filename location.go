@@ -1,6 +1,7 @@
 package aeon
 
 import (
+    "strings"
     "sync"
     "time"
 )
@@ -124,6 +125,13 @@ func (c *ZoneCache[K]) Get(name string, k K) (loc *time.Location) {
         return
     }
 
+    // 缓存与 Location 都会持有名称，避免引用调用方的内存 (如 ParseBytes 的输入)
+    if zk, ok := any(k).(zoneKey); ok {
+        name = strings.Clone(name)
+        zk.name = name
+        k = any(zk).(K)
+    }
+
     loc = time.FixedZone(name, off)
     c.cache[k] = loc
     return
@@ -143,3 +151,30 @@ func NewZone(name string, offset ...int) *time.Location {
 func NewOffset(offset int) *time.Location {
     return offsetZone.Get("", offset)
 }
+
+var namedZone sync.Map // map[string]*time.Location
+
+// LoadZone 返回 IANA 名称对应的时区，结果会被缓存。
+//
+// 与 time.LoadLocation 不同，重复加载同一名称不会再次读取时区数据库。
+func LoadZone(name string) (*time.Location, error) {
+    switch name {
+    case "", UTC:
+        return time.UTC, nil
+    case Local:
+        return time.Local, nil
+    }
+
+    if loc, ok := namedZone.Load(name); ok {
+        return loc.(*time.Location), nil
+    }
+
+    name = strings.Clone(name) // Location 会持有名称，避免引用调用方的内存
+    loc, err := time.LoadLocation(name)
+    if err != nil {
+        return nil, err
+    }
+
+    actual, _ := namedZone.LoadOrStore(name, loc)
+    return actual.(*time.Location), nil
+}
@@ -0,0 +1,45 @@
+package aeon
+
+import (
+    "log/slog"
+    "time"
+)
+
+// LogFormatter Time 在 slog 中的输出方式，为 nil 时输出带偏移的 RFC 3339 纳秒格式。
+//
+// 可设为 LogUnixMilli (毫秒级时间戳) 或 LogLayout(layout) (指定布局)，也可以自定义。
+var LogFormatter func(Time) slog.Value
+
+// LogUnixMilli 将 Time 输出为毫秒级时间戳，用作 LogFormatter
+func LogUnixMilli(t Time) slog.Value {
+    return slog.Int64Value(t.time.UnixMilli())
+}
+
+// LogLayout 返回按 layout 输出 Time 的 LogFormatter
+func LogLayout(layout string) func(Time) slog.Value {
+    return func(t Time) slog.Value {
+        return slog.StringValue(t.time.Format(layout))
+    }
+}
+
+// LogValue 实现 slog.LogValuer，按 LogFormatter 输出时间。
+func (t Time) LogValue() slog.Value {
+    if LogFormatter != nil {
+        return LogFormatter(t)
+    }
+    return slog.StringValue(t.time.Format(time.RFC3339Nano))
+}
+
+// Attr 返回 t 的日志属性
+func Attr(key string, t Time) slog.Attr {
+    return slog.Attr{Key: key, Value: t.LogValue()}
+}
+
+// IntervalAttr 返回时间区间的日志属性组，包含 start、end 和 duration (end - start)。
+func IntervalAttr(key string, start, end Time) slog.Attr {
+    return slog.Group(key,
+        slog.Attr{Key: "start", Value: start.LogValue()},
+        slog.Attr{Key: "end", Value: end.LogValue()},
+        slog.Duration("duration", end.Sub(start)),
+    )
+}
@@ -0,0 +1,52 @@
+package aeon
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	start := Parse("2024-05-20T13:14:15.5+08:00")
+	end := start.ByHour(2)
+
+	logger.Info("job", "at", start, Attr("end", end), IntervalAttr("span", start, end))
+	want := `{"level":"INFO","msg":"job","at":"2024-05-20T13:14:15.5+08:00","end":"2024-05-20T15:14:15.5+08:00",` +
+		`"span":{"start":"2024-05-20T13:14:15.5+08:00","end":"2024-05-20T15:14:15.5+08:00","duration":7200000000000}}`
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("LogValue:\n got %s\nwant %s", got, want)
+	}
+
+	LogFormatter = LogUnixMilli
+	defer func() { LogFormatter = nil }()
+
+	buf.Reset()
+	logger.Info("job", "at", start)
+	if want = `{"level":"INFO","msg":"job","at":1716182055500}`; strings.TrimSpace(buf.String()) != want {
+		t.Errorf("LogValue(UnixMilli): got %s, want %s", buf.String(), want)
+	}
+
+	// 布局与时间戳互不冲突，即使布局字符串恰好为 "UnixMilli"
+	for layout, want := range map[string]string{
+		"2006-01-02 15:04:05": `"2024-05-20 13:14:15"`,
+		"UnixMilli":           `"UnixMilli"`,
+	} {
+		LogFormatter = LogLayout(layout)
+		buf.Reset()
+		logger.Info("job", "at", start)
+		if want = `{"level":"INFO","msg":"job","at":` + want + `}`; strings.TrimSpace(buf.String()) != want {
+			t.Errorf("LogLayout(%s): got %s, want %s", layout, buf.String(), want)
+		}
+	}
+}
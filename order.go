@@ -0,0 +1,128 @@
+package aeon
+
+// DateOrder 纯数字日期 (如 "03/04/2025") 的字段顺序
+type DateOrder uint8
+
+const (
+    AutoOrder DateOrder = iota // 自动推断 (默认)
+    DMY                        // 日-月-年 (欧洲)
+    MDY                        // 月-日-年 (美国)
+    YMD                        // 年-月-日 (ISO)
+)
+
+// resolve 按 o.DateOrder 将三个数字字段解释为年月日。
+//
+// 参数 sep 为字段分隔符，short 表示末位字段是否为两位数 (两位年份)。
+// 返回值 order 为实际采用的字段顺序。
+//
+// AutoOrder 的推断规则 (依次判断)：
+//  1. 首字段大于 31：YMD
+//  2. 以 '-' 分隔且三个字段都是两位数 (如 "25-01-02")：YMD
+//  3. 首字段大于 12：DMY；次字段大于 12：MDY
+//  4. 仍无法区分时：'/' 分隔视为 MDY，其余 ('.', '-') 视为 DMY
+func (o *ParseOptions) resolve(a, b, c int, sep byte, short bool) (y, m, d int, order DateOrder) {
+    if order = o.DateOrder; order == AutoOrder {
+        order = inferOrder(a, b, sep, short)
+    }
+
+    switch order {
+    case YMD:
+        y, m, d = a, b, c
+        if a < 100 {
+            y = o.year(a)
+        }
+        return
+    case MDY:
+        m, d, y = a, b, c
+    default:
+        d, m, y = a, b, c
+    }
+
+    if short {
+        y = o.year(y)
+    }
+
+    return
+}
+
+// inferOrder 根据首、次字段的取值推断字段顺序
+func inferOrder(a, b int, sep byte, short bool) DateOrder {
+    switch {
+    case a > 31:
+        return YMD
+    case sep == '-' && short && b <= 12:
+        return YMD
+    case a > 12:
+        return DMY
+    case b > 12:
+        return MDY
+    case sep == '/':
+        return MDY
+    default:
+        return DMY
+    }
+}
+
+// dateFields 提取 s 开头 “数字 分隔符 数字 分隔符 数字” 形式的三个日期字段。
+//
+// 分隔符为 '/', '.', '-' 之一且前后一致，首、次字段为 1-2 位，末字段为 2 或 4 位。
+// 返回值 i 为日期部分的结束位置。
+func dateFields(s string) (a, b, c int, sep byte, short bool, i int, ok bool) {
+    n := len(s)
+    j := skipDigit(s, 0)
+    if j < 1 || j > 2 || j >= n {
+        return
+    }
+
+    if sep = s[j]; sep != '/' && sep != '.' && sep != '-' {
+        return
+    }
+    a, i = num(s, 0, j), j+1
+
+    if j = skipDigit(s, i); j-i < 1 || j-i > 2 || j >= n || s[j] != sep {
+        return
+    }
+    b, i = num(s, i, j), j+1
+
+    if j = skipDigit(s, i); j-i != 2 && j-i != 4 {
+        return
+    }
+    c, short, i = num(s, i, j), j-i == 2, j
+
+    return a, b, c, sep, short, i, true
+}
+
+// InferDateOrder 从一组样本 (如 CSV 的一整列) 推断数字日期的主导字段顺序。
+//
+// 每个能明确区分顺序的样本 (某字段大于 12 或首字段大于 31) 投一票，返回得票最多的顺序；
+// 没有可区分的样本时返回 AutoOrder。
+func InferDateOrder(samples []string) DateOrder {
+    var votes [4]int
+    for _, s := range samples {
+        s = trim(s)
+        if isDigit4(s) { // 以四位年份开头
+            votes[YMD]++
+            continue
+        }
+
+        a, b, _, _, _, _, ok := dateFields(s)
+        switch {
+        case !ok:
+        case a > 31:
+            votes[YMD]++
+        case a > 12 && b <= 12:
+            votes[DMY]++
+        case b > 12 && a <= 12:
+            votes[MDY]++
+        }
+    }
+
+    best := AutoOrder
+    for o := DMY; o <= YMD; o++ {
+        if votes[o] > votes[best] {
+            best = o
+        }
+    }
+
+    return best
+}
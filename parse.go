@@ -1,6 +1,7 @@
 package aeon
 
 import (
+    "errors"
     "time"
 )
 
@@ -12,37 +13,29 @@ const (
     DTNs    = "2006-01-02 15:04:05.999999999"
 )
 
+var (
+    // ErrSyntax 表示无法识别的时间字符串
+    ErrSyntax = errors.New("aeon: cannot parse time string")
+    // ErrZone 表示无法识别的时区
+    ErrZone = errors.New("aeon: unknown time zone")
+    // ErrRange 表示时间字段超出范围
+    ErrRange = errors.New("aeon: time field out of range")
+    // ErrWeekday 表示星期与日期不一致
+    ErrWeekday = errors.New("aeon: weekday does not match date")
+    // ErrNoDate 表示字符串仅有时间而没有日期 (AnchorError)
+    ErrNoDate = errors.New("aeon: time string has no date")
+)
+
+// defaultParser 包级解析函数使用的解析器，时区与周起始日在调用时读取包级默认值
+var defaultParser Parser
+
 // ParseE 解析时间字符串，返回 Time 和 error
 func ParseE(s string, loc ...*time.Location) (Time, error) {
-    if s = trim(s); s == "" || s == "null" {
-        return Time{}, nil
-    }
-
-    // 预处理时区，仅支持 Z, ±HH:mm, ±HHmm 三种标准格式。
     l := DefaultTimeZone
     if len(loc) > 0 && loc[0] != nil {
         l = loc[0]
     }
-
-    n := len(s)
-    if n > 1 && s[n-1] == 'Z' {
-        s, l = s[:n-1], time.UTC
-    } else if n >= 6 && s[n-3] == ':' { // ±HH:mm
-        sign := int(s[n-6])
-        if (sign == '+' || sign == '-') && isDigit2(s, n-5) && isDigit2(s, n-2) {
-            offset := p2(s, n-5)*3600 + p2(s, n-2)*60
-            s, l = s[:n-6], NewOffset(offset*(44-sign))
-        }
-    } else if n >= 5 { // ±HHmm
-        sign := int(s[n-5])
-        if (sign == '+' || sign == '-') && isDigit4(s[n-4:]) {
-            offset := p2(s, n-4)*3600 + p2(s, n-2)*60
-            s, l = s[:n-5], NewOffset(offset*(44-sign))
-        }
-    }
-
-    // 解析时间并返回
-    t, err := parseFast(trim(s), l)
+    t, err := defaultParser.parse(s, l)
     return Time{time: t, weekStarts: DefaultWeekStarts}, err
 }
 
@@ -52,6 +45,17 @@ func Parse(value string, loc ...*time.Location) Time {
     return t
 }
 
+// ParseBytesE 解析字节切片形式的时间字符串，不复制 b，适用于 JSON、CSV 等大缓冲区中的字段。
+func ParseBytesE(b []byte, loc ...*time.Location) (Time, error) {
+    return ParseE(btos(b), loc...)
+}
+
+// ParseBytes 解析字节切片形式的时间字符串，忽略错误
+func ParseBytes(b []byte, loc ...*time.Location) Time {
+    t, _ := ParseBytesE(b, loc...)
+    return t
+}
+
 // ParseByE 指定布局解析，返回 Time 和 error
 func ParseByE(layout string, value string, loc ...*time.Location) (Time, error) {
     l := DefaultTimeZone
@@ -70,7 +74,7 @@ func ParseBy(layout string, value string, loc ...*time.Location) Time {
 
 // parseFast 是 Aeon 的 L1 级分流决策树。
 // 它通过探测 “特征位（isSep）” 实现对标准 ISO8601 家族的 O(1) 识别。
-func parseFast(s string, loc *time.Location) (time.Time, error) {
+func parseFast(s string, loc *time.Location, o *ParseOptions) (time.Time, error) {
     n := len(s)
     if n < 1 {
         return time.Time{}, nil
@@ -81,51 +85,249 @@ func parseFast(s string, loc *time.Location) (time.Time, error) {
         y := p4(s)
         // 判定进入紧凑大类：长度为4，或者第5位是数字或小数点 (YYYYM... or YYYY.nnn)
         if n == 4 || isDigit(s[4]) || s[4] == '.' {
-            return parseCompact(s, n, y, loc)
+            // 两位年份的紧凑日期 (YYMMDD[Thh...])
+            if o.ShortYear && skipDigit(s, 4) == 6 {
+                o.record("yMD")
+                return parseDMY(s, 6, loc, o, o.year(p2(s, 0)), p2(s, 2), p2(s, 4))
+            }
+            // 基本格式序数日期 (YYYYDDD[Thh...])
+            if skipDigit(s, 4) == 7 && (n == 7 || s[7] == 'T') {
+                o.record("Yj")
+                return parseOrdinal(s, 7, y, num(s, 4, 7), loc, o)
+            }
+            o.record("YMDhms")
+            return parseCompact(s, n, y, loc, o)
+        }
+
+        // --- ISO 8601 周日期 (YYYY-Www[-D], YYYYWww[D]) 与序数日期 (YYYY-DDD) ---
+        if s[4] == 'W' {
+            return parseWeekDate(s, 4, y, loc, o)
+        }
+        if s[4] == '-' && n > 5 {
+            if s[5] == 'W' {
+                return parseWeekDate(s, 5, y, loc, o)
+            }
+            if skipDigit(s, 5) == 8 && (n == 8 || s[8] == 'T' || s[8] == ' ') {
+                o.record("Yj")
+                return parseOrdinal(s, 8, y, num(s, 5, 8), loc, o)
+            }
         }
 
         // --- 统一基因特征寻址 ---
         // 10位日期 (YYYY?MM?DD)
-        if n == 10 && isSep2(s[4], s[7]) {
-            return time.Date(y, time.Month(p2(s, 5)), p2(s, 8), 0, 0, 0, 0, loc), nil
+        if n == 10 && isSep2(s[4], s[7]) && isDigit2(s, 5) {
+            o.record("YMD")
+            return o.date(y, p2(s, 5), p2(s, 8), 0, 0, 0, 0, loc)
         }
 
         // 16 位日期时间 (YYYY?MM?DD?HH?mm)
-        if n == 16 && isSep4(s[4], s[7], s[10], s[13]) {
-            return time.Date(y, time.Month(p2(s, 5)), p2(s, 8), p2(s, 11), p2(s, 14), 0, 0, loc), nil
+        if n == 16 && isSep4(s[4], s[7], s[10], s[13]) && isDigit2(s, 11) {
+            o.record("YMDhm")
+            return o.date(y, p2(s, 5), p2(s, 8), p2(s, 11), p2(s, 14), 0, 0, loc)
         }
 
         // 19-23 位日期时间 (YYYY?MM?DD?HH?mm?ss[.SSS])
-        if (n == 19 || n == 23) && isSep5(s[4], s[7], s[10], s[13], s[16]) {
+        if (n == 19 || n == 23) && isSep5(s[4], s[7], s[10], s[13], s[16]) && isDigit2(s, 11) && isDigit2(s, 17) {
             ns, _ := parseNanoseconds(s, n, 19)
-            return time.Date(y, time.Month(p2(s, 5)), p2(s, 8), p2(s, 11), p2(s, 14), p2(s, 17), ns, loc), nil
+            o.record("YMDhms")
+            return o.date(y, p2(s, 5), p2(s, 8), p2(s, 11), p2(s, 14), p2(s, 17), ns, loc)
+        }
+
+        // --- 名称/中文单位通道 ("2025年1月2日", "2025-01-02 Thursday") ---
+        if hasWord(s, 4) {
+            return parseWords(s, loc, o)
         }
 
         // --- 通用寻址通道 (变长/异形) ---
         v, ns := parseGeneric(s, 4, 5)
         if v[0] > 0 {
-            return time.Date(y, time.Month(max(1, v[0])), max(1, v[1]), v[2], v[3], v[4], ns, loc), nil
+            o.record("YMDhms")
+            return o.date(y, max(1, v[0]), max(1, v[1]), v[2], v[3], v[4], ns, loc)
         }
     }
 
-    if (n == 8 || n == 12) && s[2] == ':' && s[5] == ':' {
+    if (n == 8 || n == 12) && s[2] == ':' && s[5] == ':' && isDigit2(s, 6) && (n == 8 || s[8] == '.') {
         ns, _ := parseNanoseconds(s, n, 8)
-        return time.Date(0, 1, 1, p2(s, 0), p2(s, 3), p2(s, 6), ns, loc), nil
+        o.record("hms")
+        return o.clock(p2(s, 0), p2(s, 3), p2(s, 6), ns, loc)
     }
 
     // 子类 A2：以时间开头 ("13:14..." 或 "2:3")
     if (n >= 3 && s[1] == ':') || (n >= 4 && isDigit(s[1]) && s[2] == ':') {
+        if hasWord(s, 2) { // 带上下午标记："3:04 PM"、"11:30 a.m."、"8:30晚上"
+            return parseWords(s, loc, o)
+        }
         v, ns := parseGeneric(s, 0, 3)
-        return time.Date(0, 1, 1, v[0], v[1], v[2], ns, loc), nil
+        o.record("hms")
+        return o.clock(v[0], v[1], v[2], ns, loc)
+    }
+
+    // 子类 B：包含名称或中文单位的日期
+    if hasWord(s, 0) {
+        // 优先尝试固定形态的 RFC 1123/2822/850, ANSIC, HTTP-date
+        if bt[s[0]]&kAlpha != 0 || (n > 3 && (s[1] == ' ' || s[2] == ' ')) {
+            if t, err := parseRFC(s, loc, o); err != ErrSyntax {
+                return t, err
+            }
+        }
+        // 再尝试自由形态："2 January 2025", "Jan 2, 2025 3:04 PM", "1月2日 下午3点"
+        return parseWords(s, loc, o)
+    }
+
+    // 子类 C：纯数字日期 ("03/04/2025", "3.4.25", "03-04-2025 15:04")
+    if a, b, c, sep, short, i, ok := dateFields(s); ok {
+        y, m, d, order := o.resolve(a, b, c, sep, short)
+        o.record(orderFields[order])
+        return parseDMY(s, i, loc, o, y, m, d)
+    }
+
+    return o.unknown()
+}
+
+// parseDMY 在已解析出年月日后，解析从 i 开始的可选时间部分 (以空格或 'T' 分隔)。
+func parseDMY(s string, i int, loc *time.Location, o *ParseOptions, y, m, d int) (time.Time, error) {
+    if i == len(s) {
+        return o.date(y, m, d, 0, 0, 0, 0, loc)
+    }
+
+    if s[i] != ' ' && s[i] != 'T' {
+        return time.Time{}, ErrSyntax
+    }
+    o.record("hms")
+
+    // 基本格式时间 (hhmm[ss[.nnn]])
+    if j := skipDigit(s, i+1); j-i == 5 || j-i == 7 {
+        var sec, ns int
+        if j-i == 7 {
+            sec = p2(s, i+5)
+        }
+        if j < len(s) && s[j] == '.' {
+            ns, j = parseNanoseconds(s, len(s), j)
+        }
+        if j != len(s) {
+            return time.Time{}, ErrSyntax
+        }
+        return o.date(y, m, d, p2(s, i+1), p2(s, i+3), sec, ns, loc)
+    }
+
+    v, ns := parseGeneric(s, i, 3)
+    return o.date(y, m, d, v[0], v[1], v[2], ns, loc)
+}
+
+// parseWeekDate 解析 ISO 8601 周日期，i 为 'W' 的位置，星期缺省为 1 (周一)。
+//
+// 扩展格式 "2025-W10-3" 与基本格式 "2025W103" 均可后跟时间。
+// 周数超出当年周数或星期不在 [1,7] 时，严格模式返回 ErrRange，否则按天数进位。
+func parseWeekDate(s string, i, y int, loc *time.Location, o *ParseOptions) (time.Time, error) {
+    if !isDigit2(s, i+1) {
+        return time.Time{}, ErrSyntax
+    }
+
+    n, ext := len(s), s[4] == '-'
+    w, wd, j := p2(s, i+1), 1, i+3
+    if ext && j+1 < n && s[j] == '-' && isDigit(s[j+1]) {
+        wd, j = int(s[j+1]-'0'), j+2
+    } else if !ext && j < n && isDigit(s[j]) {
+        wd, j = int(s[j]-'0'), j+1
+    }
+
+    if o.Strict && (w < 1 || w > isoWeeks(y) || wd < 1 || wd > 7) {
+        return time.Time{}, ErrRange
+    }
+    o.record("W") // Go 布局无法表示周日期
+
+    // 第 1 周为包含 1 月 4 日的那一周
+    yd := 4 - (int(weekday(y, 1, 4))+6)%7 + (w-1)*7 + wd - 1
+    return parseYearDay(s, j, y, yd, loc, o)
+}
+
+// parseOrdinal 解析 ISO 8601 序数日期 ("2025-045", "2025045")，yd 为年内第几天。
+// yd 超出当年天数时，严格模式返回 ErrRange，否则按天数进位。
+func parseOrdinal(s string, i, y, yd int, loc *time.Location, o *ParseOptions) (time.Time, error) {
+    if o.Strict && (yd < 1 || yd > DaysIn(y)) {
+        return time.Time{}, ErrRange
+    }
+    return parseYearDay(s, i, y, yd, loc, o)
+}
+
+// parseYearDay 将年内第 yd 天换算为年月日，再解析从 i 开始的可选时间部分。
+func parseYearDay(s string, i, y, yd int, loc *time.Location, o *ParseOptions) (time.Time, error) {
+    t := time.Date(y, 1, yd, 0, 0, 0, 0, time.UTC)
+    return parseDMY(s, i, loc, o, t.Year(), int(t.Month()), t.Day())
+}
+
+// isoWeeks 返回 ISO 年 y 的周数 (52 或 53)
+func isoWeeks(y int) int {
+    _, w := time.Date(y, 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+    return w
+}
+
+// isEpoch 判断 s 是否为 10、13、16、19 位纯数字，或带负号的任意位数字 (不会是日期)，可带小数部分
+func isEpoch(s string) bool {
+    i := 0
+    if len(s) > 1 && s[0] == '-' {
+        i = 1
+    }
+
+    switch j := skipDigit(s, i); {
+    case j == i:
+        return false
+    case i == 0 && j != 10 && j != 13 && j != 16 && j != 19:
+        return false
+    default:
+        return j == len(s) || s[j] == '.' && skipDigit(s, j+1) == len(s)
+    }
+}
+
+// parseEpoch 解析可带符号与小数的 Unix 时间戳 ("1735689600"、"1735689600.123"、"-86400")。
+//
+// 与 Unix 一致，精度按整数部分的位数推断为秒、毫秒、微秒或纳秒，小数部分为该精度下更细的值。
+func parseEpoch(s string, loc *time.Location) (time.Time, error) {
+    i, n := 0, len(s)
+    if n > 0 && bt[s[0]]&kSign != 0 {
+        i = 1
+    }
+
+    j := skipDigit(s, i)
+    if j == i || j-i > 19 || (j-i == 19 && s[i:j] > "9223372036854775807") {
+        return time.Time{}, ErrSyntax
+    }
+
+    v, frac := int64(0), 0
+    for k := i; k < j; k++ {
+        v = v*10 + int64(s[k]-'0')
+    }
+    if j < n && s[j] == '.' {
+        frac, j = parseNanoseconds(s, n, j)
+    }
+    if j != n {
+        return time.Time{}, ErrSyntax
+    }
+
+    var perSec int64 // 每秒的单位数
+    switch {
+    case v <= 9999999999: // 10位：秒
+        perSec = 1
+    case v <= 9999999999999: // 13位：毫秒
+        perSec = 1e3
+    case v <= 9999999999999999: // 16位：微秒
+        perSec = 1e6
+    default: // 19位：纳秒
+        perSec = 1e9
+    }
+
+    f := int64(frac) / perSec
+    if s[0] == '-' {
+        v, f = -v, -f
     }
 
-    return time.Time{}, nil
+    return time.Unix(v/perSec, v%perSec*(1e9/perSec)+f).In(loc), nil
 }
 
 // parseCompact 负责解析不带分隔符的紧凑格式（如 YYYYMMDD），并支持在 4, 6, 8, 10, 12, 14 位后跟随小数点表示纳秒。
-func parseCompact(s string, n int, y int, loc *time.Location) (time.Time, error) {
+func parseCompact(s string, n int, y int, loc *time.Location, o *ParseOptions) (time.Time, error) {
     if n == 4 {
-        return time.Date(y, 1, 1, 0, 0, 0, 0, loc), nil
+        return o.date(y, 1, 1, 0, 0, 0, 0, loc)
     }
 
     m, d, h, mm, sec, ns := 1, 1, 0, 0, 0, 0
@@ -174,7 +376,7 @@ func parseCompact(s string, n int, y int, loc *time.Location) (time.Time, error)
     }
 
     ns, _ = parseNanoseconds(s, n, dot)
-    return time.Date(y, time.Month(m), d, h, mm, sec, ns, loc), nil
+    return o.date(y, m, d, h, mm, sec, ns, loc)
 }
 
 // parseGeneric 是通用时间解析器，采用 “贪吃蛇” 方式逐个提取时间组件。
@@ -1,6 +1,7 @@
 package aeon
 
 import (
+	"fmt"
 	"testing"
 	"time"
 )
@@ -10,6 +11,7 @@ func TestParse(t *testing.T) {
 	oldLoc := DefaultTimeZone
 	DefaultTimeZone = time.UTC
 	defer func() { DefaultTimeZone = oldLoc }()
+	today := time.Now().UTC().Format("2006-01-02") // 仅有时间的字符串默认取今天
 
 	t.Run("Date", func(t *testing.T) {
 		assert(t, Parse("2024"), "2024-01-01 00:00:00", "2024")
@@ -21,13 +23,13 @@ func TestParse(t *testing.T) {
 	})
 
 	t.Run("Time", func(t *testing.T) {
-		assert(t, Parse("2:3"), "0000-01-01 02:03:00", "2:3")
-		assert(t, Parse("2:13"), "0000-01-01 02:13:00", "2:13")
-		assert(t, Parse("2:3:4"), "0000-01-01 02:03:04", "2:3:4")
-		assert(t, Parse("2:3:14"), "0000-01-01 02:03:14", "2:3:14")
-		assert(t, Parse("12:13:14.999"), "0000-01-01 12:13:14.999", "12:13:14.999")
-		assert(t, Parse("12:03:04"), "0000-01-01 12:03:04", "12:03:04")
-		assert(t, Parse("13:14"), "0000-01-01 13:14:00", "13:14")
+		assert(t, Parse("2:3"), today+" 02:03:00", "2:3")
+		assert(t, Parse("2:13"), today+" 02:13:00", "2:13")
+		assert(t, Parse("2:3:4"), today+" 02:03:04", "2:3:4")
+		assert(t, Parse("2:3:14"), today+" 02:03:14", "2:3:14")
+		assert(t, Parse("12:13:14.999"), today+" 12:13:14.999", "12:13:14.999")
+		assert(t, Parse("12:03:04"), today+" 12:03:04", "12:03:04")
+		assert(t, Parse("13:14"), today+" 13:14:00", "13:14")
 	})
 
 	t.Run("Number", func(t *testing.T) {
@@ -59,8 +61,8 @@ func TestParse(t *testing.T) {
 	})
 
 	t.Run("Precision", func(t *testing.T) {
-		assert(t, Parse("13:14:15.9"), "0000-01-01 13:14:15.9", "13:14:15.9")
-		assert(t, Parse("13:14:15.999"), "0000-01-01 13:14:15.999", "13:14:15.999")
+		assert(t, Parse("13:14:15.9"), today+" 13:14:15.9", "13:14:15.9")
+		assert(t, Parse("13:14:15.999"), today+" 13:14:15.999", "13:14:15.999")
 		assert(t, Parse("2020-08-05 13:14:15.123"), "2020-08-05 13:14:15.123", "2020-08-05 13:14:15.123")
 		assert(t, Parse("2020-08-05 13:14:15.123456789"), "2020-08-05 13:14:15.123456789", "2020-08-05 13:14:15.123456789")
 	})
@@ -88,7 +90,7 @@ func TestParse(t *testing.T) {
 
 		// 纯时间 + 时区
 		resTimeZ := Parse("13:14:15Z")
-		assert(t, resTimeZ, "0000-01-01 13:14:15", "13:14:15Z String")
+		assert(t, resTimeZ, today+" 13:14:15", "13:14:15Z String")
 		assertZone(t, resTimeZ, 0, "13:14:15Z Offset")
 	})
 
@@ -133,9 +135,512 @@ func TestParse(t *testing.T) {
 		assert(t, Parse("2024-01-32"), "2024-02-01 00:00:00", "2024-01-32")
 	})
 
+	t.Run("RFC", func(t *testing.T) {
+		cases := []struct {
+			in, want string
+			offset   int
+		}{
+			{"Mon, 02 Jan 2006 15:04:05 -0700", "2006-01-02 15:04:05", -7 * 3600},         // RFC 1123Z
+			{"Mon, 02 Jan 2006 15:04:05 GMT", "2006-01-02 15:04:05", 0},                   // HTTP-date
+			{"Mon, 2 Jan 2006 15:04:05 +0000 (UTC)", "2006-01-02 15:04:05", 0},            // RFC 2822 注释
+			{"Tue, 2 Jan 2024 08:30 EST", "2024-01-02 08:30:00", -5 * 3600},               // RFC 2822 无秒
+			{"02 Jan 06 15:04 MST", "2006-01-02 15:04:00", -7 * 3600},                     // RFC 822
+			{"02 Jan 06 15:04 -0700", "2006-01-02 15:04:00", -7 * 3600},                   // RFC 822Z
+			{"Monday, 02-Jan-06 15:04:05 PST", "2006-01-02 15:04:05", -8 * 3600},          // RFC 850
+			{"Sunday, 06-Nov-94 08:49:37 GMT", "1994-11-06 08:49:37", 0},                  // RFC 850 (HTTP)
+			{"Mon Jan  2 15:04:05 2006", "2006-01-02 15:04:05", 0},                        // ANSIC
+			{"Mon Jan  2 15:04:05 UTC 2006", "2006-01-02 15:04:05", 0},                    // UnixDate
+			{"Mon Jan 02 15:04:05 -0700 2006", "2006-01-02 15:04:05", -7 * 3600},          // RubyDate
+			{"Mon Jan 02 15:04:05.123 +0800 2006", "2006-01-02 15:04:05.123", 8 * 3600},   // 带纳秒
+			{"mon, 02 jan 2006 15:04:05 gmt", "2006-01-02 15:04:05", 0},                   // 大小写
+			{"Thursday, 02 January 2025 15:04:05 +0800", "2025-01-02 15:04:05", 8 * 3600}, // 全称
+		}
+		for _, c := range cases {
+			res, err := ParseE(c.in)
+			if err != nil {
+				t.Errorf("%s: %v", c.in, err)
+				continue
+			}
+			assert(t, res, c.want, c.in)
+			assertZone(t, res, c.offset, c.in)
+		}
+
+		for _, in := range []string{
+			"Mon, 02 Foo 2006 15:04:05 GMT",
+			"Mon, 32 Jan 2006 15:04:05 GMT",
+			"Mon, 02 Jan 2006 25:04:05 GMT",
+			"Mon, 02 Jan 2006 15:04:05 XYZ",
+			"Mon Jan  2 15:04:05",
+			"Mon, 02 Jan 2006 15:04:05 GMT trailing",
+		} {
+			if _, err := ParseE(in); err == nil {
+				t.Errorf("%s: want error", in)
+			}
+		}
+
+		// UT、UTC、Z 为 time.UTC 本身
+		for _, in := range []string{"Mon, 02 Jan 2006 15:04:05 UT", "Mon Jan  2 15:04:05 UTC 2006", "2006-01-02 15:04:05 UTC", "2006-01-02 15:04:05 utc"} {
+			if res, err := ParseE(in); err != nil || res.Location() != time.UTC {
+				t.Errorf("%s: got %v, %v", in, res.Location(), err)
+			}
+		}
+	})
+
+	t.Run("Words", func(t *testing.T) {
+		cases := []struct{ in, want string }{
+			{"2 January 2025", "2025-01-02 00:00:00"},
+			{"January 2025", "2025-01-01 00:00:00"},
+			{"Jan 2, 2025 3:04 PM", "2025-01-02 15:04:00"},
+			{"Jan 2, 2025 12:30 a.m.", "2025-01-02 00:30:00"},
+			{"Thursday, the 2nd of January 2025 at 3pm", "2025-01-02 15:00:00"},
+			{"2 Jan 2025 15:04:05.123", "2025-01-02 15:04:05.123"},
+			{"2025-01-02 Thursday", "2025-01-02 00:00:00"},
+			{"2025-01-02 3:04:05 pm", "2025-01-02 15:04:05"},
+			{"2025年1月2日", "2025-01-02 00:00:00"},
+			{"2025年1月2日 星期四 下午3点", "2025-01-02 15:00:00"},
+			{"2025年01月02日 15时04分05秒", "2025-01-02 15:04:05"},
+			{"2025年1月2日 周四 上午9点半", "2025-01-02 09:30:00"},
+			{"2025年1月2日 晚上8:30", "2025-01-02 20:30:00"},
+			{"2025年", "2025-01-01 00:00:00"},
+			{"下午3点", today + " 15:00:00"},
+		}
+		for _, c := range cases {
+			res, err := ParseE(c.in)
+			if err != nil {
+				t.Errorf("%s: %v", c.in, err)
+				continue
+			}
+			assert(t, res, c.want, c.in)
+		}
+
+		errs := []struct {
+			in  string
+			err error
+		}{
+			{"Friday, January 2, 2025", ErrWeekday},
+			{"2025年1月2日 星期五", ErrWeekday},
+			{"Feb 30, 2025", ErrRange},
+			{"2025年13月1日", ErrRange},
+			{"Fri, 02 Jan 2025 15:04:05 GMT", ErrWeekday},
+			{"Friday, 02-Jan-25 15:04:05 GMT", ErrWeekday},
+			{"Fri Jan  2 15:04:05 2025", ErrWeekday},
+			{"Thu, 32 Jan 2025 15:04:05 GMT", ErrRange},
+			{"Thu, 02 Jan 2025 24:04:05 GMT", ErrRange},
+			{"Thu, 02 Jan 2025 15:60:05 GMT", ErrRange},
+			{"Jan 2, 2025 foo", ErrSyntax},
+			{"Thursday", ErrSyntax},
+		}
+		for _, c := range errs {
+			if _, err := ParseE(c.in); err != c.err {
+				t.Errorf("%s: got %v, want %v", c.in, err, c.err)
+			}
+		}
+	})
+
+	t.Run("Meridiem", func(t *testing.T) {
+		cases := [][2]string{
+			{"3:04 PM", today + " 15:04:00"},
+			{"3:04PM", today + " 15:04:00"},
+			{"03:04:05 pm", today + " 15:04:05"},
+			{"3:04:05.123 PM", today + " 15:04:05.123"},
+			{"11:30 a.m.", today + " 11:30:00"},
+			{"9:15 p.m.", today + " 21:15:00"},
+			{"12:00 AM", today + " 00:00:00"},
+			{"12:30 PM", today + " 12:30:00"},
+			{"下午3:04", today + " 15:04:00"},
+			{"晚上 8:30", today + " 20:30:00"},
+			{"上午11:30", today + " 11:30:00"},
+			{"2025-01-02 03:04 PM", "2025-01-02 15:04:00"},
+			{"2025-01-02 03:04:05 PM", "2025-01-02 15:04:05"},
+			{"2025-01-02T12:15:00 a.m.", "2025-01-02 00:15:00"},
+			{"01/02/2025 12:05 PM", "2025-01-02 12:05:00"},
+			{"2025-01-02 下午3:04", "2025-01-02 15:04:00"},
+			{"13:00 PM", today + " 13:00:00"}, // 非严格模式下原样保留
+		}
+		for _, c := range cases {
+			assert(t, Parse(c[0]), c[1], c[0])
+		}
+
+		strict := ParseOptions{Strict: true}
+		for _, in := range []string{"13:00 PM", "2025-01-02 13:04 pm", "下午15点"} {
+			if _, err := strict.ParseE(in); err != ErrRange {
+				t.Errorf("%s: got %v, want ErrRange", in, err)
+			}
+		}
+	})
+
+	t.Run("DateOrder", func(t *testing.T) {
+		cases := []struct {
+			order    DateOrder
+			in, want string
+		}{
+			{AutoOrder, "03/04/2025", "2025-03-04 00:00:00"}, // '/' 无法区分时为 MDY
+			{AutoOrder, "03.04.2025", "2025-04-03 00:00:00"}, // '.' 无法区分时为 DMY
+			{AutoOrder, "13/04/2025", "2025-04-13 00:00:00"}, // 首字段 > 12
+			{AutoOrder, "04/13/2025", "2025-04-13 00:00:00"}, // 次字段 > 12
+			{AutoOrder, "3/4/25", "2025-03-04 00:00:00"},
+			{AutoOrder, "25-01-02", "2025-01-02 00:00:00"},
+			{AutoOrder, "03-04-2025 15:04:05", "2025-04-03 15:04:05"},
+			{AutoOrder, "3/4/2025 Tuesday", "2025-03-04 00:00:00"},
+			{DMY, "03/04/2025", "2025-04-03 00:00:00"},
+			{DMY, "3/4/25 8:30", "2025-04-03 08:30:00"},
+			{MDY, "03.04.2025", "2025-03-04 00:00:00"},
+			{YMD, "25/04/03", "2025-04-03 00:00:00"},
+		}
+		for _, c := range cases {
+			res, err := ParseOptions{DateOrder: c.order}.ParseE(c.in)
+			if err != nil {
+				t.Errorf("%s: %v", c.in, err)
+				continue
+			}
+			assert(t, res, c.want, c.in)
+		}
+
+		if _, err := ParseE("3/4/2025 Thursday"); err != ErrWeekday {
+			t.Errorf("3/4/2025 Thursday: got %v, want %v", err, ErrWeekday)
+		}
+
+		samples := []string{"01/02/2024", "13/02/2024", "25/12/2024", "03/04/2024", "12/31/2024"}
+		if o := InferDateOrder(samples); o != DMY {
+			t.Errorf("InferDateOrder: got %d, want DMY", o)
+		}
+		if o := InferDateOrder([]string{"01/02/2024", "03/04/2024"}); o != AutoOrder {
+			t.Errorf("InferDateOrder(ambiguous): got %d, want AutoOrder", o)
+		}
+	})
+
+	t.Run("ZoneName", func(t *testing.T) {
+		cases := []struct {
+			in, want, loc string
+			offset        int
+		}{
+			{"2025-01-02 15:04:05 Asia/Shanghai", "2025-01-02 15:04:05", "Asia/Shanghai", 8 * 3600},
+			{"2025-07-02 15:04:05 America/New_York", "2025-07-02 15:04:05", "America/New_York", -4 * 3600},
+			{"2025-01-02T15:04:05+08:00[Asia/Shanghai]", "2025-01-02 15:04:05", "Asia/Shanghai", 8 * 3600},
+			{"2025-01-02T07:04:05Z[Asia/Shanghai]", "2025-01-02 15:04:05", "Asia/Shanghai", 8 * 3600},
+			{"2025-01-02T15:04:05+09:00[Asia/Shanghai]", "2025-01-02 14:04:05", "Asia/Shanghai", 8 * 3600}, // 偏移决定时刻
+			{"2025-01-02T15:04:05[Asia/Shanghai][u-ca=iso8601]", "2025-01-02 15:04:05", "Asia/Shanghai", 8 * 3600},
+			{"2025-01-02T15:04:05[!Asia/Shanghai]", "2025-01-02 15:04:05", "Asia/Shanghai", 8 * 3600},
+			{"2025-01-02T15:04:05[+05:30]", "2025-01-02 15:04:05", "", 5*3600 + 30*60},
+			{"2025-01-02 15:04:05 UTC+8", "2025-01-02 15:04:05", "", 8 * 3600},
+			{"2025-01-02 15:04:05 UTC+05:30", "2025-01-02 15:04:05", "", 5*3600 + 30*60},
+			{"2025-01-02 15:04:05 GMT-0500", "2025-01-02 15:04:05", "", -5 * 3600},
+			{"2025-01-02 15:04:05 UTC", "2025-01-02 15:04:05", "UTC", 0},
+			{"2025-01-02 15:04:05 CST", "2025-01-02 15:04:05", "CST", -6 * 3600}, // RFC 822 缩写
+			{"2025-01-02 15:04:05 CET", "2025-01-02 15:04:05", "CET", 3600},      // 时区数据库中的缩写
+			{"2025-01-02 15:04:05.5 +0800 CST", "2025-01-02 15:04:05.5", "CST", 8 * 3600},
+			{"2025年1月2日 下午3点 Asia/Tokyo", "2025-01-02 15:00:00", "Asia/Tokyo", 9 * 3600},
+		}
+		for _, c := range cases {
+			res, err := ParseE(c.in)
+			if err != nil {
+				t.Errorf("%s: %v", c.in, err)
+				continue
+			}
+			assert(t, res, c.want, c.in)
+			assertZone(t, res, c.offset, c.in)
+			if name := res.Location().String(); c.loc != "" && name != c.loc {
+				t.Errorf("%s: location got %s, want %s", c.in, name, c.loc)
+			}
+		}
+
+		sh, _ := LoadZone(Shanghai)
+		o := ParseOptions{Zones: map[string]*time.Location{"CST": sh}}
+		for _, in := range []string{"2025-01-02 15:04:05 CST", "Thu, 02 Jan 2025 15:04:05 CST"} {
+			res, err := o.ParseE(in)
+			if err != nil {
+				t.Errorf("Zones %s: %v", in, err)
+				continue
+			}
+			assert(t, res, "2025-01-02 15:04:05", "Zones "+in)
+			if res.Location() != sh {
+				t.Errorf("Zones %s: location got %s, want Asia/Shanghai", in, res.Location())
+			}
+		}
+
+		for _, c := range []struct {
+			in  string
+			err error
+		}{
+			{"2025-01-02 15:04:05 Mars/Olympus", ErrZone},
+			{"2025-01-02T15:04:05[Mars/Olympus]", ErrZone},
+			{"2025-01-02T15:04:05+09:00[!Asia/Shanghai]", ErrZone},
+			{"2025-01-02T15:04:05[!u-ca=hebrew]", ErrSyntax},
+			{"2025-01-02T15:04:05[Asia/Shanghai][Asia/Tokyo]", ErrSyntax},
+			{"2025-01-02 15:04:05 UTC+25", ErrZone},
+			{"2025-01-02 15:04:05 XYZ", ErrZone},
+		} {
+			if _, err := ParseE(c.in); err != c.err {
+				t.Errorf("%s: got %v, want %v", c.in, err, c.err)
+			}
+		}
+	})
+
+	t.Run("ISOWeek", func(t *testing.T) {
+		cases := []struct{ in, want string }{
+			{"2025-W10-3", "2025-03-05 00:00:00"},
+			{"2025W103", "2025-03-05 00:00:00"},
+			{"2025-W10", "2025-03-03 00:00:00"},
+			{"2025W10", "2025-03-03 00:00:00"},
+			{"2025-W01-1", "2024-12-30 00:00:00"}, // 第 1 周始于上一年
+			{"2020-W53-7", "2021-01-03 00:00:00"},
+			{"2025-W10-3T15:04:05", "2025-03-05 15:04:05"},
+			{"2025W103T150405", "2025-03-05 15:04:05"},
+			{"2025-045", "2025-02-14 00:00:00"},
+			{"2025045", "2025-02-14 00:00:00"},
+			{"2024-366", "2024-12-31 00:00:00"},
+			{"2025-045 08:30", "2025-02-14 08:30:00"},
+			{"2025045T0830", "2025-02-14 08:30:00"},
+		}
+		for _, c := range cases {
+			res, err := ParseE(c.in, time.UTC)
+			if err != nil {
+				t.Errorf("%s: %v", c.in, err)
+				continue
+			}
+			assert(t, res, c.want, c.in)
+		}
+
+		res := Parse("2025-W10-3T15:04:05+08:00")
+		assert(t, res, "2025-03-05 15:04:05", "周日期+时区")
+		assertZone(t, res, 8*3600, "周日期+时区")
+
+		strict := ParseOptions{Strict: true}
+		for _, in := range []string{"2025-W53-1", "2025-W00-1", "2025-W10-8", "2025-366", "2025-000"} {
+			if _, err := strict.ParseE(in); err != ErrRange {
+				t.Errorf("%s: got %v, want ErrRange", in, err)
+			}
+		}
+		assert(t, Parse("2025-W53-1", time.UTC), "2025-12-29 00:00:00", "非严格模式进位")
+		if _, err := ParseE("2025-Wx"); err != ErrSyntax {
+			t.Errorf("2025-Wx: got %v, want ErrSyntax", err)
+		}
+
+		for _, c := range []struct{ in, week, ord string }{
+			{"2025-03-05", "2025-W10-3", "2025-064"},
+			{"2024-12-30", "2025-W01-1", "2024-365"},
+			{"2021-01-03", "2020-W53-7", "2021-003"},
+		} {
+			res := Parse(c.in)
+			if got := res.ISOWeekDate(); got != c.week {
+				t.Errorf("ISOWeekDate(%s): got %s, want %s", c.in, got, c.week)
+			}
+			if got := res.OrdinalDate(); got != c.ord {
+				t.Errorf("OrdinalDate(%s): got %s, want %s", c.in, got, c.ord)
+			}
+			if back := Parse(c.week); !back.Eq(res) {
+				t.Errorf("round trip %s: got %s", c.week, back)
+			}
+			if back := Parse(c.ord); !back.Eq(res) {
+				t.Errorf("round trip %s: got %s", c.ord, back)
+			}
+		}
+	})
+
 	t.Run("EdgeCase", func(t *testing.T) {
 		assert(t, Parse(`"2024-05-20 15:04:05"`), "2024-05-20 15:04:05", `"2024-05-20 15:04:05"`)
 		assert(t, Parse("null"), "0001-01-01 00:00:00", "null")
 		assert(t, Parse(""), "0001-01-01 00:00:00", "empty")
 	})
+
+	t.Run("Bytes", func(t *testing.T) {
+		buf := []byte(`{"at":"2025-01-02 15:04:05 +0800 XYZ"}`)
+		res, err := ParseBytesE(buf[7 : len(buf)-2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		copy(buf, "................................................")
+		assert(t, res, "2025-01-02 15:04:05", "ParseBytesE")
+		if name, off := res.Zone(); name != "XYZ" || off != 8*3600 {
+			t.Errorf("zone after buffer reuse: got %s %d", name, off)
+		}
+
+		var v Time
+		js := []byte(`"2025-01-02T15:04:05.123+08:00"`)
+		if n := testing.AllocsPerRun(100, func() { _ = v.UnmarshalJSON(js) }); n != 0 {
+			t.Errorf("UnmarshalJSON allocs: got %v, want 0", n)
+		}
+		var col any = js[1 : len(js)-1] // 驱动返回的 []byte
+		if n := testing.AllocsPerRun(100, func() { _ = v.Scan(col) }); n != 0 {
+			t.Errorf("Scan allocs: got %v, want 0", n)
+		}
+	})
+}
+
+func TestParser(t *testing.T) {
+	utc := NewParser()
+	utc.Location = time.UTC
+
+	t.Run("Defaults", func(t *testing.T) {
+		p := Parser{Location: NewOffset(8 * 3600), WeekStarts: time.Sunday}
+		res := p.Parse("2024-05-20 13:14:15")
+		assertZone(t, res, 8*3600, "Location")
+		if res.weekStarts != time.Sunday {
+			t.Errorf("WeekStarts: got %v, want Sunday", res.weekStarts)
+		}
+		assertZone(t, p.Parse("2024-05-20 13:14:15", time.UTC), 0, "loc 参数优先")
+		assertZone(t, p.Parse("2024-05-20 13:14:15-0700"), -7*3600, "字符串时区优先")
+		assert(t, p.ParseBytes([]byte("2024-05-20")), "2024-05-20 00:00:00", "ParseBytes")
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		p := utc
+		p.Strict = true
+		for _, in := range []string{"2024-13-01", "2024-02-30 10:00:00", "25:00:00", "20240230"} {
+			if _, err := p.ParseE(in); err != ErrRange {
+				t.Errorf("%s: got %v, want ErrRange", in, err)
+			}
+		}
+		if _, err := p.ParseE("not a time"); err != ErrSyntax {
+			t.Errorf("unknown: got %v, want ErrSyntax", err)
+		}
+		assert(t, p.Parse("2024-02-29 10:00:00"), "2024-02-29 10:00:00", "Strict 合法值")
+	})
+
+	t.Run("Pivot", func(t *testing.T) {
+		p := utc
+		assert(t, p.Parse("3/4/68"), "2068-03-04 00:00:00", "默认分界 69")
+		assert(t, p.Parse("3/4/69"), "1969-03-04 00:00:00", "默认分界 69")
+		p.Pivot = 50
+		assert(t, p.Parse("3/4/49"), "2049-03-04 00:00:00", "Pivot 50")
+		assert(t, p.Parse("3/4/50"), "1950-03-04 00:00:00", "Pivot 50")
+		assert(t, p.Parse("02 Jan 50 15:04 GMT"), "1950-01-02 15:04:00", "RFC 822 Pivot 50")
+
+		p = utc
+		p.Century = 1900
+		assert(t, p.Parse("02/01/25"), "1925-02-01 00:00:00", "Century 1900")
+		assert(t, p.Parse("25-01-02"), "1925-01-02 00:00:00", "Century 1900")
+		p.Century = 2000
+		assert(t, p.Parse("31/12/99"), "2099-12-31 00:00:00", "Century 2000")
+		assert(t, p.Parse("1/1/00"), "2000-01-01 00:00:00", "Century 2000")
+
+		// 滑动窗口：(今年 + 50 - 100, 今年 + 50]
+		p = utc
+		p.Window = 50
+		hi := time.Now().Year() + 50
+		last, first := hi%100, (hi+1)%100
+		assert(t, p.Parse(fmt.Sprintf("1/1/%02d", last)), fmt.Sprintf("%d-01-01 00:00:00", hi), "Window 上界")
+		assert(t, p.Parse(fmt.Sprintf("1/1/%02d", first)), fmt.Sprintf("%d-01-01 00:00:00", hi-99), "Window 下界")
+		p.Pivot = 10 // Window 优先
+		assert(t, p.Parse(fmt.Sprintf("1/1/%02d", last)), fmt.Sprintf("%d-01-01 00:00:00", hi), "Window 优先于 Pivot")
+
+		p = utc
+		assert(t, p.Parse("202501"), "2025-01-01 00:00:00", "默认 YYYYMM")
+		p.ShortYear = true
+		assert(t, p.Parse("250102"), "2025-01-02 00:00:00", "ShortYear")
+		assert(t, p.Parse("991231T235959"), "1999-12-31 23:59:59", "ShortYear 时间")
+		assert(t, p.Parse("250102 1504"), "2025-01-02 15:04:00", "ShortYear 基本格式时间")
+		assert(t, p.Parse("20250102"), "2025-01-02 00:00:00", "ShortYear 不影响 8 位")
+		if _, layout, _ := p.ParseDetect("250102T150405"); layout != "060102T150405" {
+			t.Errorf("ShortYear layout: got %q", layout)
+		}
+	})
+
+	t.Run("Anchor", func(t *testing.T) {
+		p := utc
+		today := time.Now().In(time.UTC).Format("2006-01-02")
+		assert(t, p.Parse("13:14:05"), today+" 13:14:05", "默认 AnchorToday")
+
+		p.Anchor = AnchorZero
+		assert(t, p.Parse("13:14:05"), "0000-01-01 13:14:05", "AnchorZero")
+
+		p.Anchor = AnchorToday
+		assert(t, p.Parse("13:14:05"), today+" 13:14:05", "AnchorToday")
+		assert(t, p.Parse("3:04 PM"), today+" 15:04:00", "AnchorToday 上下午")
+		assert(t, p.Parse("下午3点"), today+" 15:00:00", "AnchorToday 中文")
+		assert(t, p.Parse("2024-05-20 13:14:05"), "2024-05-20 13:14:05", "AnchorToday 不影响完整日期")
+		east := time.Now().In(NewOffset(14 * 3600)).Format("2006-01-02")
+		assert(t, p.Parse("13:14:05+14:00"), east+" 13:14:05", "AnchorToday 按所在时区")
+
+		p.Anchor, p.Ref = AnchorRef, Parse("2024-02-29 23:00:00", time.UTC)
+		assert(t, p.Parse("08:30"), "2024-02-29 08:30:00", "AnchorRef")
+		assert(t, p.Parse("8:30:15.5"), "2024-02-29 08:30:15.5", "AnchorRef 小数秒")
+
+		p.Anchor = AnchorError
+		for _, in := range []string{"13:14:05", "3:04 PM", "15时04分"} {
+			if _, err := p.ParseE(in); err != ErrNoDate {
+				t.Errorf("%s: got %v, want ErrNoDate", in, err)
+			}
+		}
+
+		// Scan 的 TIME 列与 ParseE 一致
+		defer func(old TimeAnchor) { DefaultTimeAnchor = old }(DefaultTimeAnchor)
+		DefaultTimeAnchor = AnchorError
+		var v Time
+		if err := v.Scan([]byte("13:14:15")); err != ErrNoDate {
+			t.Errorf("Scan: got %v, want ErrNoDate", err)
+		}
+		if _, err := ParseE("13:14:15"); err != ErrNoDate {
+			t.Errorf("ParseE: got %v, want ErrNoDate", err)
+		}
+	})
+
+	t.Run("Epoch", func(t *testing.T) {
+		p := utc
+		assert(t, p.Parse("@1735689600"), "2025-01-01 00:00:00", "@ 秒")
+		assert(t, p.Parse("@1735689600.123"), "2025-01-01 00:00:00.123", "@ 小数秒")
+		assert(t, p.Parse("@1735689600123"), "2025-01-01 00:00:00.123", "@ 毫秒")
+		assert(t, p.Parse("@1735689600123.5"), "2025-01-01 00:00:00.1235", "@ 小数毫秒")
+		assert(t, p.Parse("@1735689600123456"), "2025-01-01 00:00:00.123456", "@ 微秒")
+		assert(t, p.Parse("@1735689600123456789"), "2025-01-01 00:00:00.123456789", "@ 纳秒")
+		assert(t, p.Parse("@-86400.5"), "1969-12-30 23:59:59.5", "@ 负数")
+		assertZone(t, p.Parse("@0", NewOffset(8*3600)), 8*3600, "@ 时区")
+		for _, in := range []string{"@", "@abc", "@12x", "@9999999999999999999"} {
+			if _, err := p.ParseE(in); err != ErrSyntax {
+				t.Errorf("%s: got %v, want ErrSyntax", in, err)
+			}
+		}
+
+		if p.Parse("1735689600").Year() == 2025 {
+			t.Error("默认应为紧凑格式 YYYYMMDDhh")
+		}
+		p.Epoch = true
+		assert(t, p.Parse("1735689600"), "2025-01-01 00:00:00", "Epoch 秒")
+		assert(t, p.Parse("1735689600.25"), "2025-01-01 00:00:00.25", "Epoch 小数秒")
+		assert(t, p.Parse("1735689600123"), "2025-01-01 00:00:00.123", "Epoch 毫秒")
+		assert(t, p.Parse("20240520150415"), "2024-05-20 15:04:15", "Epoch 不影响 14 位紧凑格式")
+		assert(t, p.Parse("20240520"), "2024-05-20 00:00:00", "Epoch 不影响 8 位紧凑格式")
+		assert(t, p.Parse("-1735689600"), "1915-01-01 00:00:00", "Epoch 负数")
+		assert(t, p.Parse("-86400.5"), "1969-12-30 23:59:59.5", "Epoch 负数小数")
+		for _, in := range []string{"-1735689600x", "-9999999999999999999"} {
+			if got, err := p.ParseE(in); err == nil {
+				t.Errorf("%s: got %v, want error", in, got)
+			}
+		}
+	})
+
+	t.Run("Layouts", func(t *testing.T) {
+		p := utc
+		p.Layouts = []string{"02/01/2006 15h04", "2006.01.02"}
+		assert(t, p.Parse("20/05/2024 13h14"), "2024-05-20 13:14:00", "Layouts[0]")
+		assert(t, p.Parse("2024.05.20"), "2024-05-20 00:00:00", "Layouts[1]")
+		if _, err := p.ParseE("2024-05-20"); err == nil {
+			t.Error("Layouts: want error for unlisted layout")
+		}
+
+		p.Layouts, p.Fallbacks = nil, []string{"02/01/2006 15h04"}
+		assert(t, p.Parse("2024-05-20"), "2024-05-20 00:00:00", "内置解析优先")
+		assert(t, p.Parse("20/05/2024 13h14"), "2024-05-20 13:14:00", "Fallbacks")
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		dmy, mdy := utc, utc
+		dmy.DateOrder, mdy.DateOrder = DMY, MDY
+
+		done := make(chan struct{})
+		for i := 0; i < 8; i++ {
+			go func() {
+				defer func() { done <- struct{}{} }()
+				for j := 0; j < 100; j++ {
+					if dmy.Parse("03/04/2025").Month() != 4 || mdy.Parse("03/04/2025").Month() != 3 {
+						t.Error("Concurrent: parsers interfere")
+						return
+					}
+				}
+			}()
+		}
+		for i := 0; i < 8; i++ {
+			<-done
+		}
+	})
 }
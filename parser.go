@@ -0,0 +1,457 @@
+package aeon
+
+import (
+    "strings"
+    "time"
+)
+
+// ParseOptions 控制解析行为的选项，零值即为 ParseE 的默认行为。
+type ParseOptions struct {
+    // DateOrder 纯数字日期 (如 "03/04/2025") 的字段顺序，默认自动推断。
+    DateOrder DateOrder
+
+    // Strict 严格模式：字段超出范围 (如 "2024-13-01") 时返回 ErrRange 而非进位，
+    // 无法识别的字符串返回 ErrSyntax 而非零时。
+    Strict bool
+
+    // Pivot 两位年份的分界 (1-99)：小于 Pivot 展开为 20xx，否则为 19xx。
+    // 0 表示使用 69 (与标准库一致)。Century 或 Window 非零时不使用。
+    Pivot int
+
+    // Century 固定世纪的起始年 (如 1900、2000)：两位年份 yy 展开为 Century + yy，优先于 Window 与 Pivot。
+    Century int
+
+    // Window 滑动窗口 (1-99)：两位年份展开为 (当前年份 + Window - 100, 当前年份 + Window] 中的年份，
+    // 窗口随当前年份移动。如 Window 为 50 时展开为距今 ±50 年内的年份，为 20 时与 Java 的 SimpleDateFormat 一致。
+    // 优先于 Pivot。
+    Window int
+
+    // Anchor 仅有时间的字符串 (如 "13:14:05") 的日期取法，默认使用 DefaultTimeAnchor。
+    Anchor TimeAnchor
+
+    // Ref Anchor 为 AnchorRef 时使用其日期 (年月日)，为零时使用今天。
+    Ref Time
+
+    // Epoch 将 10、13、16、19 位纯数字 (可带小数，如 "1735689600.123") 解析为秒、毫秒、微秒、纳秒级的 Unix 时间戳，
+    // 而非紧凑格式；带负号的数字 (如 "-86400") 为 1970 年之前的时间戳，精度同样按位数推断。
+    // 14 位的 YYYYMMDDhhmmss 不受影响，但 10 位的 YYYYMMDDhh 将视为秒级时间戳。
+    // 不论是否开启，"@" 前缀 (如 "@1735689600") 总是表示时间戳。
+    Epoch bool
+
+    // ShortYear 将 6 位紧凑日期 (如 "250102"、"250102T150405") 解析为 YYMMDD 而非 YYYYMM。
+    ShortYear bool
+
+    // Layouts 非空时只接受这些布局 (time.Parse 格式)，依次尝试，不再使用内置解析。
+    Layouts []string
+
+    // Fallbacks 内置解析失败 (出错或无法识别) 时依次尝试的布局。
+    Fallbacks []string
+
+    // Zones 时区缩写到时区的映射 (键区分大小写)，优先于内置的 RFC 822 缩写，
+    // 用于消除 "CST" 等缩写的歧义，如 {"CST": 上海时区}。
+    Zones map[string]*time.Location
+
+    tr *trace // 非 nil 时记录解析路径，供 ParseDetect 推断布局
+}
+
+// TimeAnchor 仅有时间的字符串的日期取法
+type TimeAnchor uint8
+
+const (
+    AnchorDefault TimeAnchor = iota // 使用 DefaultTimeAnchor
+    AnchorZero                      // 0000-01-01 (与标准库一致)
+    AnchorToday                     // 所在时区的今天 (DefaultTimeAnchor 的默认值)
+    AnchorRef                       // ParseOptions.Ref 的日期
+    AnchorError                     // 返回 ErrNoDate
+)
+
+// Parser 可配置的时间解析器。
+//
+// Parser 是只读的值类型，其方法可安全地并发调用。
+// 包级的 Parse/ParseE 等价于使用 NewParser() 返回的默认解析器。
+type Parser struct {
+    Location   *time.Location // 默认时区，为 nil 时使用 DefaultTimeZone
+    WeekStarts time.Weekday   // 解析结果的周起始日
+    ParseOptions
+}
+
+// NewParser 返回使用包级默认值 (DefaultTimeZone, DefaultWeekStarts) 的解析器
+func NewParser(opts ...ParseOptions) Parser {
+    p := Parser{Location: DefaultTimeZone, WeekStarts: DefaultWeekStarts}
+    if len(opts) > 0 {
+        p.ParseOptions = opts[0]
+    }
+    return p
+}
+
+// ParseE 解析时间字符串，返回 Time 和 error。
+//
+// loc (可选) 覆盖解析器的默认时区；字符串自带的时区优先。
+func (p Parser) ParseE(s string, loc ...*time.Location) (Time, error) {
+    l := p.Location
+    if len(loc) > 0 && loc[0] != nil {
+        l = loc[0]
+    } else if l == nil {
+        l = DefaultTimeZone
+    }
+
+    t, err := p.parse(s, l)
+    return Time{time: t, weekStarts: p.WeekStarts}, err
+}
+
+// Parse 解析时间字符串，忽略错误
+func (p Parser) Parse(s string, loc ...*time.Location) Time {
+    t, _ := p.ParseE(s, loc...)
+    return t
+}
+
+// ParseBytesE 解析字节切片形式的时间字符串。
+//
+// 内置解析直接读取 b 而不复制，结果不引用 b 的内存；
+// 配置了 Layouts 或 Fallbacks 时会复制 b，因为 time.Parse 的结果可能引用输入 (如未知的时区缩写)。
+func (p Parser) ParseBytesE(b []byte, loc ...*time.Location) (Time, error) {
+    if len(p.Layouts) > 0 || len(p.Fallbacks) > 0 {
+        return p.ParseE(string(b), loc...)
+    }
+    return p.ParseE(btos(b), loc...)
+}
+
+// ParseBytes 解析字节切片形式的时间字符串，忽略错误
+func (p Parser) ParseBytes(b []byte, loc ...*time.Location) Time {
+    t, _ := p.ParseBytesE(b, loc...)
+    return t
+}
+
+// ParseE 按选项 o 解析时间字符串，返回 Time 和 error
+func (o ParseOptions) ParseE(s string, loc ...*time.Location) (Time, error) {
+    return NewParser(o).ParseE(s, loc...)
+}
+
+// Parse 按选项 o 解析时间字符串，忽略错误
+func (o ParseOptions) Parse(s string, loc ...*time.Location) Time {
+    t, _ := o.ParseE(s, loc...)
+    return t
+}
+
+func (p *Parser) parse(s string, loc *time.Location) (time.Time, error) {
+    if s = trim(s); s == "" || s == "null" {
+        return time.Time{}, nil
+    }
+
+    if len(p.Layouts) > 0 {
+        return p.parseLayouts(s, loc, p.Layouts)
+    }
+
+    t, err := p.ParseOptions.parse(s, loc)
+    if (err != nil || t.IsZero()) && len(p.Fallbacks) > 0 {
+        if ft, ferr := p.parseLayouts(s, loc, p.Fallbacks); ferr == nil {
+            return ft, nil
+        }
+    }
+
+    return t, err
+}
+
+// parse 预处理时区后交给 parseFast 解析
+func (o *ParseOptions) parse(s string, l *time.Location) (time.Time, error) {
+    if s[0] == '@' {
+        return parseEpoch(s[1:], l)
+    }
+    if o.Epoch && isEpoch(s) {
+        return parseEpoch(s, l)
+    }
+
+    // 以数字或 'Z' 结尾的字符串 (绝大多数) 没有时区名称，先不识别；
+    // 其中只有 "UTC+8" 等偏移无法直接解析，失败且末尾有时区名称时再识别。
+    if c := s[len(s)-1]; isDigit(c) || c == 'Z' {
+        t, err := o.parseZone(s, l, zoneName{})
+        if (err == nil && !t.IsZero()) || !hasZoneWord(s) {
+            return t, err
+        }
+        if o.tr != nil {
+            *o.tr = trace{}
+        }
+    }
+
+    s, z, err := o.zoneSuffix(s)
+    if err != nil {
+        return time.Time{}, err
+    }
+    return o.parseZone(s, l, z)
+}
+
+// parseZone 预处理 s 末尾的偏移后交给 parseFast 解析，再将结果置于时区名称 z 所指的时区
+func (o *ParseOptions) parseZone(s string, l *time.Location, z zoneName) (time.Time, error) {
+    // 预处理偏移，仅支持 Z, ±HH:mm, ±HHmm 三种标准格式。
+    hasOff, n := true, len(s)
+    switch {
+    case n > 1 && s[n-1] == 'Z':
+        s, l = s[:n-1], time.UTC
+    case n >= 6 && s[n-3] == ':' && isOffset(s, n-6) && isDigit2(s, n-2): // ±HH:mm
+        offset := p2(s, n-5)*3600 + p2(s, n-2)*60
+        s, l = s[:n-6], NewOffset(offset*(44-int(s[n-6])))
+    case n >= 5 && isOffset(s, n-5) && isDigit2(s, n-2): // ±HHmm
+        offset := p2(s, n-4)*3600 + p2(s, n-2)*60
+        s, l = s[:n-5], NewOffset(offset*(44-int(s[n-5])))
+    default:
+        if hasOff = false; z.loc != nil {
+            l = z.loc
+        }
+    }
+
+    t, err := parseFast(trim(s), l, o)
+    if err != nil || t.IsZero() || (z.loc == nil && z.name == "") {
+        return t, err
+    }
+
+    return z.apply(t, hasOff)
+}
+
+// zoneName 字符串中的时区名称
+type zoneName struct {
+    loc      *time.Location // 已识别的时区
+    name     string         // 时区缩写 (loc 为 nil 时表示未知缩写)
+    bracket  bool           // 来自 RFC 9557 方括号后缀
+    critical bool           // 方括号后缀带有 "!" 标记
+}
+
+// hasZoneWord 判断 s 的最后一个词 (以空格分隔) 是否以字母开头，即可能是时区名称
+func hasZoneWord(s string) bool {
+    k := strings.LastIndexByte(s, ' ')
+    return k >= 0 && k < len(s)-1 && bt[s[k+1]]&kAlpha != 0
+}
+
+// zoneSuffix 识别并去除 s 末尾的时区名称，返回剩余部分。
+//
+// 支持的形式：
+//   - RFC 9557 后缀："[Asia/Shanghai]"、"[!Asia/Shanghai]"、"[+08:00]"，忽略 "[u-ca=iso8601]" 等扩展标签。
+//   - 以空格分隔的 IANA 名称："2025-01-02 15:04:05 Asia/Shanghai"
+//   - UTC/GMT 偏移："UTC+8"、"UTC+08:00"、"GMT-0500"
+//   - 时区缩写：o.Zones 中的键、RFC 822 缩写 ("GMT", "EST")，或 3-5 位大写字母的未知缩写。
+func (o *ParseOptions) zoneSuffix(s string) (string, zoneName, error) {
+    var z zoneName
+    for n := len(s); n > 2 && s[n-1] == ']'; n = len(s) {
+        i := strings.LastIndexByte(s, '[')
+        if i < 0 {
+            return s, z, ErrSyntax
+        }
+
+        tag := s[i+1 : n-1]
+        s = s[:i]
+        crit := tag != "" && tag[0] == '!'
+        if crit {
+            tag = tag[1:]
+        }
+
+        if strings.IndexByte(tag, '=') >= 0 { // 扩展标签：非关键标签可忽略
+            if crit {
+                return s, z, ErrSyntax
+            }
+            continue
+        }
+
+        if z.loc != nil { // 只允许一个时区后缀
+            return s, z, ErrSyntax
+        }
+
+        if off, ok := parseOffset(tag); ok {
+            z.loc = NewOffset(off)
+        } else if loc, err := LoadZone(tag); err == nil && tag != "" {
+            z.loc = loc
+        } else {
+            return s, z, ErrZone
+        }
+        z.bracket, z.critical = true, crit
+    }
+
+    if z.loc != nil {
+        return s, z, nil
+    }
+
+    if !hasZoneWord(s) {
+        return s, z, nil
+    }
+    k := strings.LastIndexByte(s, ' ')
+
+    switch tok := s[k+1:]; {
+    case strings.IndexByte(tok, '/') > 0: // IANA 名称
+        loc, err := LoadZone(tok)
+        if err != nil {
+            return s, z, ErrZone
+        }
+        z.loc = loc
+    case len(tok) > 3 && (eqFold(tok[:3], "UTC") || eqFold(tok[:3], "GMT")) && bt[tok[3]]&kSign != 0:
+        off, ok := parseOffset(tok[3:])
+        if !ok {
+            return s, z, ErrZone
+        }
+        z.loc = NewOffset(off)
+    default:
+        if z.loc = o.zone(tok); z.loc == nil && !isAbbr(tok) {
+            return s, z, nil
+        }
+        z.name = tok
+    }
+
+    return trim(s[:k]), z, nil
+}
+
+// apply 将解析结果 t 置于时区名称 z 所指的时区。
+//
+// 字符串同时带有偏移时，偏移决定时刻：若与 z 在该时刻的偏移一致则使用 z，
+// 否则方括号后缀与 IANA 名称仅改变显示时区 (带 "!" 标记时返回 ErrZone)，缩写则作为该偏移的名称。
+// 仅有未知缩写时，它必须与所在时区在该时刻的缩写一致，否则返回 ErrZone。
+func (z zoneName) apply(t time.Time, hasOff bool) (time.Time, error) {
+    if !hasOff {
+        if z.loc != nil {
+            return t, nil
+        }
+        if name, _ := t.Zone(); eqFold(z.name, name) {
+            return t, nil
+        }
+        if loc, err := LoadZone(z.name); err == nil { // 如 "CET"、"EST"
+            y, m, d := t.Date()
+            h, mm, sec := t.Clock()
+            t = time.Date(y, m, d, h, mm, sec, t.Nanosecond(), loc)
+            if name, _ := t.Zone(); eqFold(z.name, name) {
+                return t, nil
+            }
+        }
+        return time.Time{}, ErrZone
+    }
+
+    if z.loc != nil {
+        _, off := t.Zone()
+        if _, zoff := t.In(z.loc).Zone(); zoff == off {
+            return t.In(z.loc), nil
+        }
+        if z.name == "" {
+            if z.critical {
+                return time.Time{}, ErrZone
+            }
+            return t.In(z.loc), nil
+        }
+    }
+
+    return t.In(restoreZone(z.name, t)), nil
+}
+
+// zone 返回时区缩写对应的时区：优先使用 o.Zones，其次为 RFC 822 缩写，未知缩写返回 nil。
+func (o *ParseOptions) zone(abbr string) *time.Location {
+    if loc := o.Zones[abbr]; loc != nil {
+        return loc
+    }
+    return rfcZone(abbr)
+}
+
+// isOffset 判断 s[i:] 是否以 ±HH 开头
+func isOffset(s string, i int) bool {
+    return bt[s[i]]&kSign != 0 && isDigit2(s, i+1)
+}
+
+// isAbbr 判断 s 是否形如时区缩写 (3-5 位大写字母，且不是月份或星期名称)
+func isAbbr(s string) bool {
+    if len(s) < 3 || len(s) > 5 || lookupMonth(s) > 0 || lookupWeekday(s) >= 0 {
+        return false
+    }
+    for i := 0; i < len(s); i++ {
+        if s[i]-'A' > 'Z'-'A' {
+            return false
+        }
+    }
+    return true
+}
+
+// parseOffset 解析 "±H"、"±HH"、"±H:mm"、"±HH:mm"、"±HHmm" 形式的偏移，返回秒数。
+func parseOffset(s string) (int, bool) {
+    if len(s) < 2 || bt[s[0]]&kSign == 0 {
+        return 0, false
+    }
+
+    h, m, r := 0, 0, s[1:]
+    switch j := skipDigit(r, 0); {
+    case j == len(r) && (j == 1 || j == 2):
+        h = num(r, 0, j)
+    case j == len(r) && j == 4:
+        h, m = p2(r, 0), p2(r, 2)
+    case (j == 1 || j == 2) && len(r) == j+3 && r[j] == ':' && isDigit2(r, j+1):
+        h, m = num(r, 0, j), p2(r, j+1)
+    default:
+        return 0, false
+    }
+
+    if h > 23 || m > 59 {
+        return 0, false
+    }
+    return (h*3600 + m*60) * (44 - int(s[0])), true
+}
+
+// parseLayouts 依次使用 layouts 解析 s，返回第一个成功的结果或最后一个错误。
+func (o *ParseOptions) parseLayouts(s string, loc *time.Location, layouts []string) (t time.Time, err error) {
+    for _, layout := range layouts {
+        if t, err = time.ParseInLocation(layout, s, loc); err == nil {
+            if o.tr != nil {
+                o.tr.layout = layout
+            }
+            return
+        }
+    }
+    return time.Time{}, err
+}
+
+// year 将两位年份按 Century、Window 或 Pivot 展开为四位年份
+func (o *ParseOptions) year(yy int) int {
+    if o.Century != 0 {
+        return o.Century + yy
+    }
+
+    if o.Window > 0 && o.Window < 100 {
+        hi := time.Now().Year() + o.Window
+        return hi - (hi-yy)%100 // 不超过 hi 且末两位为 yy 的最大年份
+    }
+
+    pivot := o.Pivot
+    if pivot <= 0 || pivot > 99 {
+        pivot = 69
+    }
+    if yy >= pivot {
+        return 1900 + yy
+    }
+    return 2000 + yy
+}
+
+// date 构造时间。严格模式下字段超出范围时返回 ErrRange，否则按 time.Date 的规则进位。
+func (o *ParseOptions) date(y, m, d, h, mm, sec, ns int, loc *time.Location) (time.Time, error) {
+    if o.Strict && (m < 1 || m > 12 || d < 1 || d > DaysIn(y, m) || h > 23 || mm > 59 || sec > 59) {
+        return time.Time{}, ErrRange
+    }
+    return time.Date(y, time.Month(m), d, h, mm, sec, ns, loc), nil
+}
+
+// clock 构造仅有时间的结果，日期按 o.Anchor 确定。
+func (o *ParseOptions) clock(h, mm, sec, ns int, loc *time.Location) (time.Time, error) {
+    anchor := o.Anchor
+    if anchor == AnchorDefault {
+        anchor = DefaultTimeAnchor
+    }
+
+    y, m, d := 0, time.January, 1
+    switch {
+    case anchor == AnchorError:
+        return time.Time{}, ErrNoDate
+    case anchor == AnchorRef && !o.Ref.time.IsZero():
+        y, m, d = o.Ref.time.Date()
+    case anchor == AnchorToday, anchor == AnchorRef:
+        y, m, d = time.Now().In(loc).Date()
+    }
+
+    return o.date(y, int(m), d, h, mm, sec, ns, loc)
+}
+
+// unknown 返回无法识别的字符串的结果：严格模式下为 ErrSyntax，否则为零时。
+func (o *ParseOptions) unknown() (time.Time, error) {
+    if o.Strict {
+        return time.Time{}, ErrSyntax
+    }
+    return time.Time{}, nil
+}
@@ -0,0 +1,890 @@
+package aeon
+
+import (
+    "fmt"
+    "strings"
+    "time"
+)
+
+// Locale 相对时间表达式的语言
+type Locale int
+
+const (
+    LocaleAuto Locale = iota // 自动识别：包含非 ASCII 字符时按中文解析
+    LocaleEN                 // 英文
+    LocaleZH                 // 中文
+)
+
+// RelativeError 表示相对时间表达式中无法识别的部分
+type RelativeError struct {
+    Expr string // 原始表达式
+    Pos  int    // 无法识别部分在 Expr 中的起始位置 (字节)
+}
+
+func (e *RelativeError) Error() string {
+    if e.Pos >= len(e.Expr) {
+        return fmt.Sprintf("aeon: incomplete relative expression %q", e.Expr)
+    }
+    return fmt.Sprintf("aeon: cannot parse %q in relative expression %q", e.Expr[e.Pos:], e.Expr)
+}
+
+// Unwrap 返回 ErrSyntax，使 errors.Is(err, ErrSyntax) 成立。
+func (e *RelativeError) Unwrap() error { return ErrSyntax }
+
+var (
+    // relUnitsEN 英文时间单位 (单数)
+    relUnitsEN = [...]struct {
+        name string
+        unit Unit
+    }{
+        {"second", Second}, {"sec", Second}, {"minute", Minute}, {"min", Minute}, {"hour", Hour},
+        {"day", Day}, {"week", Week}, {"month", Month}, {"quarter", Quarter}, {"year", Year},
+        {"decade", Decade}, {"century", Century}, {"centuries", Century},
+    }
+
+    // relOrdinalsEN 英文序数词，"last" 表示倒数第 1 个。
+    relOrdinalsEN = [...]string{"last", "first", "second", "third", "fourth", "fifth"}
+
+    // relDaysZH 中文相对日
+    relDaysZH = [...]struct {
+        word string
+        n    int
+    }{
+        {"大后天", 3}, {"大前天", -3}, {"今天", 0}, {"今日", 0}, {"明天", 1}, {"明日", 1},
+        {"后天", 2}, {"後天", 2}, {"昨天", -1}, {"昨日", -1}, {"前天", -2},
+    }
+
+    // relYearsZH 中文相对年
+    relYearsZH = [...]struct {
+        word string
+        n    int
+    }{
+        {"今年", 0}, {"明年", 1}, {"去年", -1}, {"后年", 2}, {"後年", 2}, {"前年", -2},
+    }
+
+    // relPrefixZH 中文相对前缀，如 "下个月"、"上周"、"本季度"。
+    relPrefixZH = [...]struct {
+        word string
+        n    int
+    }{
+        {"下下个", 2}, {"下下", 2}, {"上上个", -2}, {"上上", -2}, {"下个", 1}, {"下", 1},
+        {"上个", -1}, {"上", -1}, {"这个", 0}, {"这", 0}, {"本", 0},
+    }
+
+    // relUnitsZH 中文时间单位
+    relUnitsZH = [...]struct {
+        word string
+        unit Unit
+    }{
+        {"星期", Week}, {"礼拜", Week}, {"禮拜", Week}, {"周", Week}, {"週", Week},
+        {"季度", Quarter}, {"季", Quarter}, {"月", Month}, {"年", Year},
+        {"天", Day}, {"日", Day}, {"小时", Hour}, {"小時", Hour}, {"钟头", Hour},
+        {"分钟", Minute}, {"分鐘", Minute}, {"秒钟", Second}, {"秒", Second},
+    }
+
+    // relDigitsZH 中文数字 (索引即数值)
+    relDigitsZH = [...]string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+)
+
+// step 一次级联调用，等价于 cascade(t, act, fill, unit, 0, args[:n]...)。
+type step struct {
+    act    Action
+    fill   bool
+    unit   Unit
+    args   [4]int
+    n      int
+    within Unit // 非 0 时结果须与调用前位于同一个该单位内 (如第五个周五须在该月内)
+    pos    int  // 所属子句在表达式中的位置，用于 within 不满足时的错误
+}
+
+// relParser 将相对时间表达式编译为级联调用序列
+type relParser struct {
+    s       string
+    i       int
+    sw      time.Weekday // 周起始日，用于将星期换算为周内序号
+    steps   []step
+    clock   [3]int // 时刻 (时 分 秒)，在所有日期步骤之后应用
+    nc      int    // clock 中有效分量的个数，0 表示未指定时刻
+    anchors uint32 // 已定位的单位 (按位)，同一单位只能定位一次
+    clause  int    // 当前子句的起始位置
+}
+
+// ParseRelative 解析相对于 ref 的自然语言时间表达式。
+//
+// 表达式被编译为一组级联调用后依次作用于 ref，例如：
+//   - "next friday 6pm"           ➜ StartInWeek(1, 5, 18)
+//   - "end of last quarter"       ➜ EndByQuarter(-1)
+//   - "3rd tuesday of next month" ➜ StartInMonth(1).GoWeek(Ord, 3, 2).StartDay(0)
+//   - "in 2 weeks"、"3 days ago"   ➜ ByWeek(2)、ByDay(-3)
+//   - "明天下午三点"                 ➜ StartInDay(1, 15)
+//   - "下个月第三个周二"、"上季度末"、"三天后"
+//
+// 星期按 ref 的周起始日换算。无法识别的部分、重复的定位 (如 "tomorrow tomorrow")
+// 以及周期内不存在的序数星期 (如四月的 "fifth friday") 以 *RelativeError 返回，此时 errors.Is(err, ErrSyntax) 为 true。
+func ParseRelative(expr string, ref Time, locale Locale) (Time, error) {
+    p := relParser{s: expr, sw: ref.weekStarts}
+    if locale == LocaleAuto {
+        locale = LocaleEN
+        for i := 0; i < len(expr); i++ {
+            if expr[i] >= 0x80 {
+                locale = LocaleZH
+                break
+            }
+        }
+    }
+
+    ok := false
+    if p.skip(); p.i < len(p.s) {
+        if locale == LocaleZH {
+            ok = p.parseZH()
+        } else {
+            ok = p.parseEN()
+        }
+    }
+    if !ok {
+        return ref, &RelativeError{Expr: expr, Pos: p.i}
+    }
+
+    if p.nc > 0 {
+        p.at()
+    }
+
+    t := ref
+    for _, s := range p.steps {
+        u := cascade(t, s.act, s.fill, s.unit, 0, s.args[:s.n]...)
+        if s.within != 0 && !u.IsSame(s.within, t) {
+            return ref, &RelativeError{Expr: expr, Pos: s.pos}
+        }
+        t = u
+    }
+    return t, nil
+}
+
+// --- 编译 ---
+
+// add 追加一次级联调用
+func (p *relParser) add(act Action, fill bool, u Unit, args ...int) {
+    s := step{act: act, fill: fill, unit: u}
+    s.n = copy(s.args[:], args)
+    p.steps = append(p.steps, s)
+}
+
+// anchor 追加定位到相对第 n 个单位起点的调用：n 为 0 时为 Start<Unit>()，否则为 StartIn<Unit>(n)。
+// 该单位已经定位过时 (如 "tomorrow tomorrow") 返回 false。
+func (p *relParser) anchor(u Unit, n int) bool {
+    if !p.mark(u) {
+        return false
+    }
+    if n == 0 {
+        p.add(seAbs, false, u, 0)
+    } else {
+        p.add(seIn, false, u, n)
+    }
+    return true
+}
+
+// mark 记录单位 u 已定位，重复定位时返回 false
+func (p *relParser) mark(u Unit) bool {
+    if p.anchors&(1<<u) != 0 {
+        return false
+    }
+    p.anchors |= 1 << u
+    return true
+}
+
+// bound 追加定位到相对第 n 个单位边界的调用：Start/End<Unit>() 或 Start/EndBy<Unit>(n)。
+// month 大于 0 时定位到本年的该月。
+func (p *relParser) bound(fill bool, u Unit, n, month int) {
+    switch {
+    case month > 0:
+        p.add(seAbs, fill, Month, month)
+    case n == 0:
+        p.add(seAbs, fill, u)
+    default:
+        p.add(seRel, fill, u, n)
+    }
+}
+
+// weekday 追加定位到相对第 n 周星期 wd 的调用：StartWeekday(i) 或 StartInWeek(n, i)。
+// 已经定位过星期时返回 false。
+func (p *relParser) weekday(n int, wd time.Weekday) bool {
+    if !p.mark(Weekday) {
+        return false
+    }
+    i := int(wd-p.sw+7)%7 + 1 // 周内序号
+    if n == 0 {
+        p.add(seAbs, false, Weekday, i)
+    } else {
+        p.add(seIn, false, Week, n, i)
+    }
+    return true
+}
+
+// nth 追加定位到周期内第 k 个 (k < 0 为倒数) 星期 wd 的调用，周期为相对第 n 个月或季度，
+// month 大于 0 时为本年的该月。周期内没有第 k 个该星期时 (如四月的第五个周五)，ParseRelative 返回错误。
+func (p *relParser) nth(k int, wd time.Weekday, u Unit, n, month int) {
+    if month > 0 {
+        p.add(seAbs, false, Month, month)
+    } else if n != 0 {
+        p.add(seIn, false, u, n)
+    }
+
+    mask := Ord
+    if u == Quarter {
+        mask |= Qtr
+    }
+    if wd == time.Sunday { // 序数周中星期按 1-7 (周一至周日) 计
+        wd = 7
+    }
+
+    // 倒数序数周仅在 Go 模式下定位到周期末，因此先跳转再回到当天零点。
+    p.add(goAbs, false, Week, mask, k, int(wd))
+    if month > 0 {
+        u = Month
+    }
+    s := &p.steps[len(p.steps)-1]
+    s.within, s.pos = u, p.clause
+    p.add(seAbs, false, Day, 0)
+}
+
+// setClock 记录时刻，一个表达式只能指定一次。
+func (p *relParser) setClock(h, mm, sec int) bool {
+    if p.nc > 0 || h > 23 || mm > 59 || sec > 59 {
+        return false
+    }
+    p.clock, p.nc = [3]int{h, mm, sec}, 1
+    if mm != 0 || sec != 0 {
+        p.nc = 2
+    }
+    if sec != 0 {
+        p.nc = 3
+    }
+    return true
+}
+
+// at 应用时刻：若上一步是非置满的定位调用且下一个级联单位恰为小时，则并入其参数，
+// 如 StartInWeek(1, 5) ➜ StartInWeek(1, 5, 18)；否则追加 StartDay(0, h, mm, sec)。
+func (p *relParser) at() {
+    if n := len(p.steps); n > 0 {
+        s := &p.steps[n-1]
+        pos := s.n
+        if pos > 0 && s.args[0] < flagThreshold {
+            pos-- // 不计标志位
+        }
+        seq := s.unit.seq()
+        if !s.fill && (s.act == seAbs || s.act == seIn) && pos > 0 &&
+            pos < len(seq) && seq[pos] == Hour && s.n+p.nc <= len(s.args) {
+            s.n += copy(s.args[s.n:], p.clock[:p.nc])
+            return
+        }
+    }
+    p.add(seAbs, false, Day, append([]int{0}, p.clock[:p.nc]...)...)
+}
+
+// --- 词法 ---
+
+// skip 跳过空白与标点
+func (p *relParser) skip() {
+    for p.i < len(p.s) {
+        switch c := p.s[p.i]; {
+        case c == ' ' || c == ',' || c == '\t':
+            p.i++
+        case c >= 0x80:
+            if k := matchAny(p.s[p.i:], cjkSeps[:]); k >= 0 {
+                p.i += len(cjkSeps[k])
+                continue
+            }
+            return
+        default:
+            return
+        }
+    }
+}
+
+// word 返回从 p.i 开始的英文单词 (不消耗)
+func (p *relParser) word() string {
+    return p.s[p.i:skipAlpha(p.s, p.i)]
+}
+
+// accept 若下一个单词忽略大小写等于 words 之一则消耗它
+func (p *relParser) accept(words ...string) bool {
+    p.skip()
+    if w := p.word(); w != "" && matchFold(w, words) {
+        p.i += len(w)
+        return true
+    }
+    return false
+}
+
+// prefix 若 p.s[p.i:] 以 w 开头则消耗它
+func (p *relParser) prefix(w string) bool {
+    if strings.HasPrefix(p.s[p.i:], w) {
+        p.i += len(w)
+        return true
+    }
+    return false
+}
+
+// --- 英文 ---
+
+// parseEN 解析英文表达式
+func (p *relParser) parseEN() bool {
+    for p.skip(); p.i < len(p.s); p.skip() {
+        start := p.i
+        if p.clause = start; !p.clauseEN() {
+            p.i = start
+            return false
+        }
+    }
+    return true
+}
+
+// clauseEN 解析一个英文子句
+func (p *relParser) clauseEN() bool {
+    w := p.word()
+    if w == "" {
+        if isDigit(p.s[p.i]) {
+            return p.numberEN()
+        }
+        return false
+    }
+
+    switch {
+    case matchFold(w, []string{"at", "on", "the", "and", "now"}):
+        p.i += len(w)
+        return true
+    case eqFold(w, "today"):
+        p.i += len(w)
+        return p.anchor(Day, 0)
+    case eqFold(w, "tomorrow"), eqFold(w, "yesterday"):
+        p.i += len(w)
+        return p.anchor(Day, sign(eqFold(w, "tomorrow")))
+    case eqFold(w, "noon"), eqFold(w, "midnight"):
+        p.i += len(w)
+        return p.setClock(12*btoi(eqFold(w, "noon")), 0, 0)
+    case eqFold(w, "day"): // the day after tomorrow / the day before yesterday
+        p.i += len(w)
+        switch {
+        case p.accept("after") && p.accept("tomorrow"):
+            return p.anchor(Day, 2)
+        case p.accept("before") && p.accept("yesterday"):
+            return p.anchor(Day, -2)
+        }
+        return false
+    case eqFold(w, "in"): // in 2 weeks, in an hour and 30 minutes
+        p.i += len(w)
+        return p.quantitiesEN(1)
+    case eqFold(w, "a"), eqFold(w, "an"): // a week ago
+        return p.quantitiesEN(0)
+    case matchFold(w, []string{"start", "beginning", "end"}):
+        p.i += len(w)
+        p.accept("of")
+        u, n, month, ok := p.periodEN()
+        if ok {
+            p.bound(eqFold(w, "end"), u, n, month)
+        }
+        return ok
+    }
+
+    if k := indexFold(w, relOrdinalsEN[:]); k >= 0 { // first tuesday of ...
+        save := p.i
+        p.i += len(w)
+        if p.nthEN(k) {
+            return true
+        }
+        p.i = save
+    }
+
+    if n, ok := relDirEN(w); ok { // next friday, last month, this week
+        p.i += len(w)
+        p.skip()
+        v := p.word()
+        if wd := lookupWeekday(v); wd >= 0 {
+            p.i += len(v)
+            return p.weekday(n, wd)
+        }
+        if u, ok := lookupUnitEN(v); ok {
+            p.i += len(v)
+            return p.anchor(u, n)
+        }
+        return false
+    }
+
+    if wd := lookupWeekday(w); wd >= 0 { // friday
+        p.i += len(w)
+        return p.weekday(0, wd)
+    }
+
+    return false
+}
+
+// numberEN 解析以数字开头的子句：时刻 ("6pm", "18:30")、序数 ("3rd tuesday of ...") 或数量 ("3 days ago")。
+func (p *relParser) numberEN() bool {
+    s, i := p.s, p.i
+    j := skipDigit(s, i)
+    if j-i > 4 {
+        return false
+    }
+    v := num(s, i, j)
+
+    // 序数：3rd tuesday of next month
+    if e := skipAlpha(s, j); e > j && v > 0 && matchFold(s[j:e], ordinals[:]) {
+        p.i = e
+        return p.nthEN(v)
+    }
+
+    // 时刻：h[:mm[:ss]] [am|pm]
+    mm, sec, colon := 0, 0, false
+    if j+2 < len(s) && s[j] == ':' && isDigit2(s, j+1) {
+        mm, j, colon = p2(s, j+1), j+3, true
+        if j+2 < len(s) && s[j] == ':' && isDigit2(s, j+1) {
+            sec, j = p2(s, j+1), j+3
+        }
+    }
+    k := j
+    for k < len(s) && s[k] == ' ' {
+        k++
+    }
+    if mer, e := englishMeridiem(s, k, skipAlpha(s, k)); mer != meridiemNone {
+        if v < 1 || v > 12 {
+            return false
+        }
+        p.i = e
+        return p.setClock(to24(v, mer), mm, sec)
+    }
+    if colon {
+        p.i = j
+        return p.setClock(v, mm, sec)
+    }
+
+    return p.quantitiesEN(0)
+}
+
+// quantitiesEN 解析一个或多个 "N unit"，并按方向追加 By<Unit>(±N)。
+// dir 为 0 时方向由其后的 "ago" (过去) 或 "later"/"from now"/"hence" (将来) 决定。
+func (p *relParser) quantitiesEN(dir int) bool {
+    var (
+        units [8]Unit
+        nums  [8]int
+        k     int
+    )
+
+    for ; k < len(units); k++ {
+        p.skip()
+        if k > 0 && p.accept("and") {
+            p.skip()
+        }
+
+        save, v := p.i, 0
+        if j := skipDigit(p.s, p.i); j > p.i && j-p.i <= 9 {
+            v, p.i = num(p.s, p.i, j), j
+        } else if p.accept("a", "an") {
+            v = 1
+        } else {
+            break
+        }
+
+        p.skip()
+        w := p.word()
+        u, ok := lookupUnitEN(w)
+        if !ok {
+            p.i = save
+            break
+        }
+        nums[k], units[k], p.i = v, u, p.i+len(w)
+    }
+
+    if k == 0 {
+        return false
+    }
+
+    if dir == 0 {
+        switch {
+        case p.accept("ago"):
+            dir = -1
+        case p.accept("later"), p.accept("hence"):
+            dir = 1
+        case p.accept("from") && p.accept("now"):
+            dir = 1
+        default:
+            return false
+        }
+    }
+
+    for i := 0; i < k; i++ {
+        p.add(goRel, false, units[i], dir*nums[i])
+    }
+    return true
+}
+
+// nthEN 解析序数之后的 "<weekday> of <period>"，k 为序数 (0 表示 "last")。
+func (p *relParser) nthEN(k int) bool {
+    p.skip()
+    w := p.word()
+    wd := lookupWeekday(w)
+    if wd < 0 || k > 5 {
+        return false
+    }
+    p.i += len(w)
+
+    if !p.accept("of", "in") {
+        return false
+    }
+    u, n, month, ok := p.periodEN()
+    if !ok || (month == 0 && u != Month && u != Quarter) {
+        return false
+    }
+
+    if k == 0 {
+        k = -1
+    }
+    p.nth(k, wd, u, n, month)
+    return true
+}
+
+// periodEN 解析周期："[the|next|last|this] <unit>"、月份名称或 "today"/"tomorrow"/"yesterday"。
+func (p *relParser) periodEN() (u Unit, n, month int, ok bool) {
+    p.accept("the")
+    p.skip()
+    w := p.word()
+    switch {
+    case eqFold(w, "today"):
+        u, ok = Day, true
+    case eqFold(w, "tomorrow"), eqFold(w, "yesterday"):
+        u, n, ok = Day, sign(eqFold(w, "tomorrow")), true
+    default:
+        if month = lookupMonth(w); month > 0 {
+            ok = true
+            break
+        }
+        if d, rel := relDirEN(w); rel {
+            p.i += len(w)
+            p.skip()
+            w, n = p.word(), d
+        }
+        u, ok = lookupUnitEN(w)
+    }
+
+    if ok {
+        p.i += len(w)
+    }
+    return
+}
+
+// relDirEN 返回相对方向词的偏移：next ➜ 1, last/previous ➜ -1, this/coming ➜ 0。
+func relDirEN(w string) (int, bool) {
+    switch {
+    case eqFold(w, "next"):
+        return 1, true
+    case eqFold(w, "last"), eqFold(w, "previous"), eqFold(w, "prev"):
+        return -1, true
+    case eqFold(w, "this"), eqFold(w, "current"):
+        return 0, true
+    }
+    return 0, false
+}
+
+// lookupUnitEN 返回英文时间单位 (可为复数)
+func lookupUnitEN(w string) (Unit, bool) {
+    for i := 0; i < 2; i++ {
+        for _, x := range relUnitsEN {
+            if eqFold(w, x.name) {
+                return x.unit, true
+            }
+        }
+        if len(w) < 2 || w[len(w)-1]|0x20 != 's' {
+            break
+        }
+        w = w[:len(w)-1] // 复数
+    }
+    return 0, false
+}
+
+// --- 中文 ---
+
+// parseZH 解析中文表达式
+func (p *relParser) parseZH() bool {
+    for p.skip(); p.i < len(p.s); p.skip() {
+        start := p.i
+        if p.clause = start; !p.clauseZH() {
+            p.i = start
+            return false
+        }
+    }
+    return true
+}
+
+// clauseZH 解析一个中文子句
+func (p *relParser) clauseZH() bool {
+    rest := p.s[p.i:]
+
+    if p.prefix("的") || p.prefix("在") || p.prefix("现在") || p.prefix("此刻") {
+        return true
+    }
+
+    for _, d := range relDaysZH { // 明天、大后天
+        if p.prefix(d.word) {
+            return p.anchor(Day, d.n)
+        }
+    }
+
+    for _, y := range relYearsZH { // 明年、去年底
+        if p.prefix(y.word) {
+            return p.periodZH(Year, y.n)
+        }
+    }
+
+    if m := matchMeridiem(rest); m >= 0 { // 下午三点 (须先于 "上"/"下" 前缀)
+        p.i += len(cjkMeridiem[m].word)
+        return p.clockZH(cjkMeridiem[m].mer)
+    }
+
+    if strings.HasPrefix(rest, "第") || strings.HasPrefix(rest, "最后") { // 第三个周二 (本月)
+        return p.nthZH(Month, 0)
+    }
+
+    if k := matchAny(rest, cjkWeekdayPrefix[:]); k >= 0 { // 周五、星期天
+        if d := matchAny(rest[len(cjkWeekdayPrefix[k]):], cjkWeekdays[:]); d >= 0 {
+            p.i += len(cjkWeekdayPrefix[k]) + len(cjkWeekdays[d])
+            return p.weekday(0, time.Weekday(d%7))
+        }
+    }
+
+    for _, x := range relPrefixZH { // 下周五、上个月、本季度末
+        if !strings.HasPrefix(rest, x.word) {
+            continue
+        }
+        save := p.i
+        p.i += len(x.word)
+        if u, ok := p.unitZH(); ok && isPeriod(u) {
+            return p.periodZH(u, x.n)
+        }
+        p.i = save
+        break
+    }
+
+    if u, ok := p.unitZH(); ok && isPeriod(u) { // 月底、年初
+        return p.boundZH(u, 0)
+    }
+
+    if v, ok := p.numberZH(); ok { // 三天后、3点、15:30
+        return p.afterNumberZH(v)
+    }
+
+    return false
+}
+
+// periodZH 解析相对周期之后的部分：星期 (下周五)、边界 (上季度末)、序数 (下个月第三个周二) 或定位 (下个月)。
+func (p *relParser) periodZH(u Unit, n int) bool {
+    if u == Week {
+        if d := matchAny(p.s[p.i:], cjkWeekdays[:]); d >= 0 {
+            p.i += len(cjkWeekdays[d])
+            return p.weekday(n, time.Weekday(d%7))
+        }
+    }
+
+    if p.boundZH(u, n) {
+        return true
+    }
+
+    if u == Month || u == Quarter {
+        save := p.i
+        p.prefix("的")
+        if strings.HasPrefix(p.s[p.i:], "第") || strings.HasPrefix(p.s[p.i:], "最后") {
+            return p.nthZH(u, n)
+        }
+        p.i = save
+    }
+
+    return p.anchor(u, n)
+}
+
+// boundZH 解析周期边界后缀：初 (起点)、底/末/尾 (终点)。
+func (p *relParser) boundZH(u Unit, n int) bool {
+    switch {
+    case p.prefix("初"):
+        p.bound(false, u, n, 0)
+    case p.prefix("底"), p.prefix("末"), p.prefix("尾"):
+        p.bound(true, u, n, 0)
+    default:
+        return false
+    }
+    return true
+}
+
+// nthZH 解析 "第N个<星期>" 或 "最后一个<星期>"
+func (p *relParser) nthZH(u Unit, n int) bool {
+    k := -1
+    if p.prefix("第") {
+        v, ok := p.numberZH()
+        if !ok || v < 1 || v > 5 {
+            return false
+        }
+        k = v
+    } else if !p.prefix("最后") {
+        return false
+    } else {
+        p.prefix("一")
+    }
+    p.prefix("个")
+
+    rest := p.s[p.i:]
+    w := matchAny(rest, cjkWeekdayPrefix[:])
+    if w < 0 {
+        return false
+    }
+    d := matchAny(rest[len(cjkWeekdayPrefix[w]):], cjkWeekdays[:])
+    if d < 0 {
+        return false
+    }
+    p.i += len(cjkWeekdayPrefix[w]) + len(cjkWeekdays[d])
+
+    p.nth(k, time.Weekday(d%7), u, n, 0)
+    return true
+}
+
+// afterNumberZH 解析数字之后的部分：时刻 ("3点半"、"15:30") 或数量 ("3天后"、"两个月前")。
+func (p *relParser) afterNumberZH(v int) bool {
+    if p.i < len(p.s) && p.s[p.i] == ':' {
+        return p.clockAfterZH(v, meridiemNone)
+    }
+    if u := matchUnit(p.s[p.i:]); u >= 0 && cjkUnits[u].id == 3 {
+        return p.clockAfterZH(v, meridiemNone)
+    }
+
+    p.prefix("个")
+    u, ok := p.unitZH()
+    if !ok {
+        return false
+    }
+
+    switch {
+    case p.prefix("之后"), p.prefix("以后"), p.prefix("后"), p.prefix("後"):
+        p.add(goRel, false, u, v)
+    case p.prefix("之前"), p.prefix("以前"), p.prefix("前"):
+        p.add(goRel, false, u, -v)
+    default:
+        return false
+    }
+    return true
+}
+
+// clockZH 解析时段标记之后的时刻，如 "下午" 之后的 "3点半"。
+func (p *relParser) clockZH(mer int) bool {
+    v, ok := p.numberZH()
+    if !ok {
+        return false
+    }
+    return p.clockAfterZH(v, mer)
+}
+
+// clockAfterZH 解析小时数 h 之后的部分："点/时 [N分|半]" 或 ":mm[:ss]"。
+func (p *relParser) clockAfterZH(h, mer int) bool {
+    mm, sec := 0, 0
+    if p.i < len(p.s) && p.s[p.i] == ':' {
+        s, j := p.s, p.i
+        if !isDigit2(s, j+1) {
+            return false
+        }
+        mm, j = p2(s, j+1), j+3
+        if j+2 < len(s) && s[j] == ':' && isDigit2(s, j+1) {
+            sec, j = p2(s, j+1), j+3
+        }
+        p.i = j
+    } else {
+        u := matchUnit(p.s[p.i:])
+        if u < 0 || cjkUnits[u].id != 3 {
+            return false
+        }
+        p.i += len(cjkUnits[u].unit)
+
+        if p.prefix("半") {
+            mm = 30
+        } else if v, ok := p.numberZH(); ok { // 3点15、3点15分
+            mm = v
+            p.prefix("分")
+        }
+    }
+
+    if mer != meridiemNone && (h < 1 || h > 12) {
+        return false
+    }
+    return p.setClock(to24(h, mer), mm, sec)
+}
+
+// unitZH 解析中文时间单位
+func (p *relParser) unitZH() (Unit, bool) {
+    for _, x := range relUnitsZH {
+        if p.prefix(x.word) {
+            return x.unit, true
+        }
+    }
+    return 0, false
+}
+
+// numberZH 解析阿拉伯数字或 0-99 的中文数字 ("三", "两", "十五", "二十")。
+func (p *relParser) numberZH() (int, bool) {
+    s := p.s
+    if j := skipDigit(s, p.i); j > p.i {
+        if j-p.i > 9 {
+            return 0, false
+        }
+        v := num(s, p.i, j)
+        p.i = j
+        return v, true
+    }
+
+    digit := func() int {
+        if p.prefix("两") || p.prefix("兩") {
+            return 2
+        }
+        if d := matchAny(s[p.i:], relDigitsZH[:]); d >= 0 {
+            p.i += len(relDigitsZH[d])
+            return d
+        }
+        return -1
+    }
+
+    v := digit()
+    if p.prefix("十") {
+        if v < 0 {
+            v = 1
+        }
+        v *= 10
+        if d := digit(); d > 0 {
+            v += d
+        }
+    }
+    return v, v >= 0
+}
+
+// isPeriod 判断 u 是否可作为中文相对周期 (周、月、季度、年)
+func isPeriod(u Unit) bool {
+    return u == Week || u == Month || u == Quarter || u == Year
+}
+
+// indexFold 返回 w 忽略大小写在 words 中的索引，未找到返回 -1。
+func indexFold(w string, words []string) int {
+    for i, x := range words {
+        if eqFold(w, x) {
+            return i
+        }
+    }
+    return -1
+}
+
+// sign 返回 b 对应的方向：true ➜ 1，false ➜ -1。
+func sign(b bool) int {
+    if b {
+        return 1
+    }
+    return -1
+}
+
+// btoi 返回 b 对应的整数：true ➜ 1，false ➜ 0。
+func btoi(b bool) int {
+    if b {
+        return 1
+    }
+    return 0
+}
@@ -0,0 +1,159 @@
+package aeon
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRelative(t *testing.T) {
+	ref := Parse("2025-03-05 10:30:00", time.UTC) // 周三
+
+	t.Run("EN", func(t *testing.T) {
+		cases := []struct {
+			expr string
+			want Time
+		}{
+			{"next friday 6pm", ref.StartInWeek(1, 5, 18)},
+			{"end of last quarter", ref.EndByQuarter(-1)},
+			{"3rd tuesday of next month", ref.StartInMonth(1).GoWeek(Ord, 3, 2).StartDay(0)},
+			{"in 2 weeks", ref.ByWeek(2)},
+			{"now", ref},
+			{"today", ref.StartDay()},
+			{"tomorrow at 9:30am", ref.StartInDay(1, 9, 30)},
+			{"Yesterday noon", ref.StartInDay(-1, 12)},
+			{"the day after tomorrow", ref.StartInDay(2)},
+			{"friday", ref.StartWeekday(5)},
+			{"last monday", ref.StartInWeek(-1, 1)},
+			{"this sunday 18:00", ref.StartWeekday(7, 18)},
+			{"next month", ref.StartInMonth(1)},
+			{"this week", ref.StartWeek()},
+			{"start of next year", ref.StartByYear(1)},
+			{"end of the month", ref.EndMonth()},
+			{"end of today", ref.EndDay()},
+			{"beginning of march", ref.StartMonth(3)},
+			{"3 days ago", ref.ByDay(-3)},
+			{"a week ago", ref.ByWeek(-1)},
+			{"in an hour and 30 minutes", ref.ByHour(1).ByMinute(30)},
+			{"2 years from now", ref.ByYear(2)},
+			{"in 2 days at 6 pm", ref.ByDay(2).StartDay(0, 18)},
+			{"6pm tomorrow", ref.StartInDay(1, 18)},
+			{"last friday of the month", ref.GoWeek(Ord, -1, 5).StartDay(0)},
+			{"first monday of next quarter", ref.StartInQuarter(1).GoWeek(Qtr|Ord, 1, 1).StartDay(0)},
+			{"second sunday of may", ref.StartMonth(5).GoWeek(Ord, 2, 7).StartDay(0)},
+			{"midnight", ref.StartDay(0, 0)},
+		}
+		for _, c := range cases {
+			res, err := ParseRelative(c.expr, ref, LocaleEN)
+			if err != nil {
+				t.Errorf("%s: %v", c.expr, err)
+				continue
+			}
+			assert(t, res, c.want.String(), c.expr)
+		}
+
+		spot := map[string]string{
+			"next friday 6pm":              "2025-03-14 18:00:00",
+			"end of last quarter":          "2024-12-31 23:59:59.999999999",
+			"3rd tuesday of next month":    "2025-04-15 00:00:00",
+			"last friday of the month":     "2025-03-28 00:00:00",
+			"first monday of next quarter": "2025-04-07 00:00:00",
+			"second sunday of may":         "2025-05-11 00:00:00",
+		}
+		for expr, want := range spot {
+			res, _ := ParseRelative(expr, ref, LocaleAuto)
+			assert(t, res, want, expr)
+		}
+	})
+
+	t.Run("ZH", func(t *testing.T) {
+		cases := []struct {
+			expr string
+			want Time
+		}{
+			{"明天下午三点", ref.StartInDay(1, 15)},
+			{"下周五下午6点", ref.StartInWeek(1, 5, 18)},
+			{"上季度末", ref.EndByQuarter(-1)},
+			{"下个月第三个周二", ref.StartInMonth(1).GoWeek(Ord, 3, 2).StartDay(0)},
+			{"本月最后一个星期五", ref.GoWeek(Ord, -1, 5).StartDay(0)},
+			{"三天后", ref.ByDay(3)},
+			{"两个小时前", ref.ByHour(-2)},
+			{"2周以后", ref.ByWeek(2)},
+			{"大后天", ref.StartInDay(3)},
+			{"昨天晚上8点半", ref.StartInDay(-1, 20, 30)},
+			{"今天 15:30", ref.StartDay(0, 15, 30)},
+			{"星期天", ref.StartWeekday(7)},
+			{"上周一", ref.StartInWeek(-1, 1)},
+			{"月底", ref.EndMonth()},
+			{"下个月初", ref.StartByMonth(1)},
+			{"明年", ref.StartInYear(1)},
+			{"去年底", ref.EndByYear(-1)},
+			{"下下周", ref.StartInWeek(2)},
+			{"十二点十五分", ref.StartDay(0, 12, 15)},
+		}
+		for _, c := range cases {
+			res, err := ParseRelative(c.expr, ref, LocaleAuto)
+			if err != nil {
+				t.Errorf("%s: %v", c.expr, err)
+				continue
+			}
+			assert(t, res, c.want.String(), c.expr)
+		}
+	})
+
+	t.Run("WeekStarts", func(t *testing.T) {
+		sun := ref.WithWeekStarts(time.Sunday)
+		res, _ := ParseRelative("friday", sun, LocaleEN)
+		assert(t, res, "2025-03-07 00:00:00", "周日为周首时的 friday")
+		res, _ = ParseRelative("下周日", sun, LocaleZH)
+		assert(t, res, "2025-03-09 00:00:00", "周日为周首时的下周日")
+	})
+
+	t.Run("FifthWeekday", func(t *testing.T) {
+		res, err := ParseRelative("fifth saturday of this month", ref, LocaleEN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert(t, res, "2025-03-29 00:00:00", "三月的第五个周六")
+		res, _ = ParseRelative("本月第五个周六", ref, LocaleZH)
+		assert(t, res, "2025-03-29 00:00:00", "本月第五个周六")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		cases := []struct {
+			expr   string
+			locale Locale
+			pos    int
+		}{
+			{"", LocaleAuto, 0},
+			{"next friday banana", LocaleEN, 12},
+			{"in 2 bananas", LocaleEN, 0},
+			{"3 days", LocaleEN, 0},
+			{"13pm", LocaleEN, 0},
+			{"6pm 7pm", LocaleEN, 4},
+			{"fifth", LocaleEN, 0},
+			{"明天下午", LocaleZH, 6},
+			{"下周五 blah", LocaleZH, 10},
+			{"tomorrow tomorrow", LocaleEN, 9},
+			{"friday next friday", LocaleEN, 7},
+			{"明天明天", LocaleZH, 6},
+			{"fifth friday of next month", LocaleEN, 0}, // 四月只有四个周五
+			{"tomorrow 5th friday of april", LocaleEN, 9},
+			{"下个月第五个周五", LocaleZH, 0},
+		}
+		for _, c := range cases {
+			res, err := ParseRelative(c.expr, ref, c.locale)
+			var re *RelativeError
+			if !errors.As(err, &re) || !errors.Is(err, ErrSyntax) {
+				t.Errorf("%q: got %v, want *RelativeError", c.expr, err)
+				continue
+			}
+			if re.Pos != c.pos {
+				t.Errorf("%q: Pos got %d, want %d (%v)", c.expr, re.Pos, c.pos, err)
+			}
+			if !res.Eq(ref) {
+				t.Errorf("%q: got %s, want ref on error", c.expr, res)
+			}
+		}
+	})
+}
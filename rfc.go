@@ -0,0 +1,253 @@
+package aeon
+
+import (
+    "time"
+)
+
+var (
+    // monthNames 月份全称 (小写)，前 3 个字母即为缩写。
+    monthNames = [12]string{
+        "january", "february", "march", "april", "may", "june",
+        "july", "august", "september", "october", "november", "december",
+    }
+
+    // weekdayNames 星期全称 (小写)，前 3 个字母即为缩写。
+    weekdayNames = [7]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+    // rfcZones RFC 822/2822 定义的时区缩写及其偏移 (秒)
+    rfcZones = [...]struct {
+        name   string
+        offset int
+    }{
+        {"UT", 0}, {"UTC", 0}, {"GMT", 0}, {"Z", 0},
+        {"EST", -5 * 3600}, {"EDT", -4 * 3600},
+        {"CST", -6 * 3600}, {"CDT", -5 * 3600},
+        {"MST", -7 * 3600}, {"MDT", -6 * 3600},
+        {"PST", -8 * 3600}, {"PDT", -7 * 3600},
+    }
+)
+
+// eqFold 判断两个 ASCII 字母串是否忽略大小写相等
+func eqFold(s, t string) bool {
+    if len(s) != len(t) {
+        return false
+    }
+    for i := 0; i < len(s); i++ {
+        if s[i]|0x20 != t[i]|0x20 {
+            return false
+        }
+    }
+    return true
+}
+
+// lookupName 在 names 中查找 s (全称或 3 字母缩写)，返回索引，未找到返回 -1。
+func lookupName(s string, names []string) int {
+    if len(s) < 3 {
+        return -1
+    }
+    for i, name := range names {
+        if eqFold(s, name) || eqFold(s, name[:3]) {
+            return i
+        }
+    }
+    return -1
+}
+
+// lookupMonth 返回月份名称对应的月份 (1-12)，未找到返回 0。
+func lookupMonth(s string) int {
+    if eqFold(s, "sept") {
+        return 9
+    }
+    return lookupName(s, monthNames[:]) + 1
+}
+
+// lookupWeekday 返回星期名称对应的星期，未找到返回 -1。
+func lookupWeekday(s string) time.Weekday {
+    return time.Weekday(lookupName(s, weekdayNames[:]))
+}
+
+// skipAlpha 返回从 i 开始的连续字母的结束位置
+func skipAlpha(s string, i int) int {
+    for ; i < len(s) && bt[s[i]]&kAlpha != 0; i++ {
+    }
+    return i
+}
+
+// skipDigit 返回从 i 开始的连续数字的结束位置
+func skipDigit(s string, i int) int {
+    for ; i < len(s) && isDigit(s[i]); i++ {
+    }
+    return i
+}
+
+// skipSpace 跳过空格与逗号
+func skipSpace(s string, i int) int {
+    for ; i < len(s) && (s[i] == ' ' || s[i] == ','); i++ {
+    }
+    return i
+}
+
+// num 解析 s[i:j] 中的十进制数字
+func num(s string, i, j int) (v int) {
+    for ; i < j; i++ {
+        v = v*10 + int(s[i]-'0')
+    }
+    return
+}
+
+// parseRFC 解析以星期或月份名称开头的邮件、HTTP 与 Unix 日期格式：
+//
+//   - RFC 1123 / RFC 2822: "Mon, 02 Jan 2006 15:04:05 MST" "Mon, 2 Jan 2006 15:04:05 -0700 (MST)"
+//   - RFC 822:             "02 Jan 06 15:04 MST"
+//   - RFC 850:             "Monday, 02-Jan-06 15:04:05 MST"
+//   - ANSIC:               "Mon Jan  2 15:04:05 2006"
+//   - UnixDate / RubyDate: "Mon Jan  2 15:04:05 MST 2006" "Mon Jan 02 15:04:05 -0700 2006"
+//
+// 时区缩写接受 o.Zones 中的名称、RFC 822 定义的名称，或与 loc 在该时刻的缩写相同的名称。
+// 字段超出范围时返回 ErrRange，星期与日期不一致时返回 ErrWeekday。
+func parseRFC(s string, loc *time.Location, o *ParseOptions) (time.Time, error) {
+    n := len(s)
+    y, m, d := 0, 0, 0
+
+    // 1. 可选的星期前缀
+    i, wd := 0, time.Weekday(-1)
+    if j := skipAlpha(s, 0); j > 0 {
+        if wd = lookupWeekday(s[:j]); wd >= 0 {
+            i = skipSpace(s, j)
+        }
+    }
+
+    if i >= n {
+        return time.Time{}, ErrSyntax
+    }
+
+    // 2. 日期：DD Mon YYYY (RFC 系列) 或 Mon DD (ANSIC 系列)
+    ansic := bt[s[i]]&kAlpha != 0
+    if ansic {
+        j := skipAlpha(s, i)
+        if m = lookupMonth(s[i:j]); m == 0 {
+            return time.Time{}, ErrSyntax
+        }
+        i = skipSpace(s, j)
+        if j = skipDigit(s, i); j-i < 1 || j-i > 2 {
+            return time.Time{}, ErrSyntax
+        }
+        d, i = num(s, i, j), j
+    } else {
+        j := skipDigit(s, i)
+        if j-i < 1 || j-i > 2 || j >= n || (s[j] != ' ' && s[j] != '-') {
+            return time.Time{}, ErrSyntax
+        }
+        d, i = num(s, i, j), j+1
+
+        j = skipAlpha(s, i)
+        if m = lookupMonth(s[i:j]); m == 0 || j >= n || (s[j] != ' ' && s[j] != '-') {
+            return time.Time{}, ErrSyntax
+        }
+        i = j + 1
+
+        if y, i = parseRFCYear(s, i, o); y < 0 {
+            return time.Time{}, ErrSyntax
+        }
+    }
+
+    // 3. 时间：HH:mm[:ss[.nnn]]
+    i = skipSpace(s, i)
+    if !isDigit2(s, i) || i+5 > n || s[i+2] != ':' || !isDigit2(s, i+3) {
+        return time.Time{}, ErrSyntax
+    }
+    h, mm, sec, ns := p2(s, i), p2(s, i+3), 0, 0
+    fields := "DYhm" // 数字块依次对应的字段 (见 record)
+    if ansic {
+        fields = "DhmY"
+    }
+    if i += 5; i < n && s[i] == ':' {
+        if !isDigit2(s, i+1) {
+            return time.Time{}, ErrSyntax
+        }
+        sec, i = p2(s, i+1), i+3
+        if fields = "DYhms"; ansic {
+            fields = "DhmsY"
+        }
+        if i < n && s[i] == '.' {
+            ns, i = parseNanoseconds(s, n, i)
+        }
+    }
+
+    // 4. 时区与 (ANSIC 系列的) 年份，顺序不固定
+    abbr := "" // 未知的时区缩写
+    for i = skipSpace(s, i); i < n; i = skipSpace(s, i) {
+        switch c := s[i]; {
+        case bt[c]&kSign != 0 && i+5 <= n && isDigit4(s[i+1:]): // ±hhmm
+            loc, i = NewOffset((p2(s, i+1)*3600+p2(s, i+3)*60)*(44-int(c))), i+5
+        case bt[c]&kAlpha != 0: // 时区缩写
+            j := skipAlpha(s, i)
+            if z := o.zone(s[i:j]); z != nil {
+                loc = z
+            } else {
+                abbr = s[i:j]
+            }
+            i = j
+        case c == '(': // RFC 2822 注释，如 "(UTC)"
+            for ; i < n && s[i] != ')'; i++ {
+            }
+            if i == n {
+                return time.Time{}, ErrSyntax
+            }
+            i++
+        case isDigit(c) && ansic && y == 0:
+            j := skipDigit(s, i)
+            if j-i != 4 {
+                return time.Time{}, ErrSyntax
+            }
+            y, i = p4(s[i:]), j
+        default:
+            return time.Time{}, ErrSyntax
+        }
+    }
+
+    if y == 0 {
+        return time.Time{}, ErrSyntax
+    }
+    if d < 1 || d > DaysIn(y, m) || h > 23 || mm > 59 || sec > 59 {
+        return time.Time{}, ErrRange
+    }
+    if wd >= 0 && weekday(y, m, d) != wd {
+        return time.Time{}, ErrWeekday
+    }
+
+    t := time.Date(y, time.Month(m), d, h, mm, sec, ns, loc)
+    if abbr != "" { // 未知缩写：仅当与 loc 在该时刻的缩写一致时接受
+        if name, _ := t.Zone(); !eqFold(abbr, name) {
+            return time.Time{}, ErrZone
+        }
+    }
+
+    o.record(fields)
+    return t, nil
+}
+
+// parseRFCYear 解析从 i 开始的 2 位或 4 位年份，失败时返回 y=-1。
+func parseRFCYear(s string, i int, o *ParseOptions) (y, j int) {
+    switch j = skipDigit(s, i); j - i {
+    case 2:
+        return o.year(p2(s, i)), j
+    case 4:
+        return p4(s[i:]), j
+    default:
+        return -1, j
+    }
+}
+
+// rfcZone 返回 RFC 822 时区缩写对应的时区，未知缩写返回 nil。
+func rfcZone(abbr string) *time.Location {
+    for _, z := range rfcZones {
+        if eqFold(abbr, z.name) {
+            if z.name == "UT" || z.name == "UTC" || z.name == "Z" {
+                return time.UTC
+            }
+            return NewZone(z.name, z.offset)
+        }
+    }
+    return nil
+}
@@ -0,0 +1,217 @@
+//go:build ignore
+
+// gen 由 source 目录中的 tz 数据库源文件生成时区目录 zones_gen.go：
+//
+//   - zone1970.tab: 规范时区的国家代码、坐标与说明
+//   - tzdata.zi:    版本、链接 (别名) 与各时区当前的标准时间偏移
+//
+// 更新时将新版本的 zone1970.tab 与 tzdata.zi (如 /usr/share/zoneinfo 或 tzdata 发布包 make tzdata.zi 的产物)
+// 复制到 source 目录，再运行 go generate。
+package main
+
+import (
+    "bufio"
+    "bytes"
+    "flag"
+    "fmt"
+    "go/format"
+    "log"
+    "math"
+    "os"
+    "path/filepath"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+var (
+    src = flag.String("src", "source", "tz 源文件所在目录")
+    out = flag.String("o", "zones_gen.go", "输出文件")
+)
+
+type zone struct {
+    name      string
+    countries []string
+    lat, lon  float64
+    comment   string
+    aliases   []string
+    stdOffset int
+}
+
+func main() {
+    flag.Parse()
+    log.SetFlags(0)
+
+    version, links, offsets := readZi(filepath.Join(*src, "tzdata.zi"))
+    zones := readTab(filepath.Join(*src, "zone1970.tab"))
+
+    index := make(map[string]*zone, len(zones))
+    for _, z := range zones {
+        off, ok := offsets[z.name]
+        if !ok {
+            log.Fatalf("gen: %s 不在 tzdata.zi 中", z.name)
+        }
+        z.stdOffset = off
+        index[z.name] = z
+    }
+
+    for alias, target := range links {
+        for i := 0; i < 8; i++ { // 链接指向链接时继续解析
+            next, ok := links[target]
+            if !ok {
+                break
+            }
+            target = next
+        }
+        if z, ok := index[target]; ok {
+            z.aliases = append(z.aliases, alias)
+        }
+    }
+
+    sort.Slice(zones, func(i, j int) bool { return zones[i].name < zones[j].name })
+
+    var b bytes.Buffer
+    fmt.Fprintf(&b, "// Code generated by gen.go from tzdata %s; DO NOT EDIT.\n\n", version)
+    b.WriteString("package tzdata\n\n")
+    fmt.Fprintf(&b, "// Version 生成时区目录的 tz 数据库版本。\n// 它只描述目录，嵌入的时区数据来自构建所用 Go 工具链的 time/tzdata，版本可能不同。\nconst Version = %q\n\n", version)
+    b.WriteString("var zones = [...]Zone{\n")
+    for _, z := range zones {
+        sort.Strings(z.aliases)
+        fmt.Fprintf(&b, "{Name: %q, Countries: %s, Lat: %s, Lon: %s, ", z.name, strs(z.countries), coord(z.lat), coord(z.lon))
+        if z.comment != "" {
+            fmt.Fprintf(&b, "Comment: %q, ", z.comment)
+        }
+        if len(z.aliases) > 0 {
+            fmt.Fprintf(&b, "Aliases: %s, ", strs(z.aliases))
+        }
+        fmt.Fprintf(&b, "StdOffset: %d},\n", z.stdOffset)
+    }
+    b.WriteString("}\n")
+
+    code, err := format.Source(b.Bytes())
+    if err != nil {
+        log.Fatalf("gen: %v", err)
+    }
+    if err = os.WriteFile(*out, code, 0o644); err != nil {
+        log.Fatalf("gen: %v", err)
+    }
+}
+
+// readTab 读取 zone1970.tab：国家代码 (逗号分隔)、ISO 6709 坐标、时区名称与可选的说明，以制表符分隔
+func readTab(path string) []*zone {
+    var zones []*zone
+    scan(path, func(line string) {
+        if strings.HasPrefix(line, "#") {
+            return
+        }
+        f := strings.Split(line, "\t")
+        if len(f) < 3 {
+            log.Fatalf("gen: %s: 无法识别的行 %q", path, line)
+        }
+        z := &zone{name: f[2], countries: strings.Split(f[0], ",")}
+        z.lat, z.lon = iso6709(f[1])
+        if len(f) > 3 {
+            z.comment = f[3]
+        }
+        zones = append(zones, z)
+    })
+    return zones
+}
+
+// readZi 读取 tzdata.zi，返回版本、链接 (别名 → 目标) 与各时区最后一行规则的标准时间偏移 (秒)
+func readZi(path string) (version string, links map[string]string, offsets map[string]int) {
+    links, offsets = map[string]string{}, map[string]int{}
+    name := ""
+    scan(path, func(line string) {
+        f := strings.Fields(line)
+        switch {
+        case len(f) == 0:
+        case f[0] == "#":
+            if len(f) == 3 && f[1] == "version" {
+                version = f[2]
+            }
+        case f[0] == "Z": // Z 名称 标准偏移 规则 格式 [截止时间]
+            name = f[1]
+            offsets[name] = hms(f[2])
+        case f[0] == "L": // L 目标 别名
+            links[f[2]] = f[1]
+        case f[0] == "R":
+        default: // 续行：标准偏移 规则 格式 [截止时间]
+            offsets[name] = hms(f[0])
+        }
+    })
+    if version == "" {
+        log.Fatalf("gen: %s 缺少版本", path)
+    }
+    return version, links, offsets
+}
+
+func scan(path string, fn func(line string)) {
+    file, err := os.Open(path)
+    if err != nil {
+        log.Fatalf("gen: %v", err)
+    }
+    defer file.Close()
+
+    s := bufio.NewScanner(file)
+    for s.Scan() {
+        fn(s.Text())
+    }
+    if err = s.Err(); err != nil {
+        log.Fatalf("gen: %v", err)
+    }
+}
+
+// hms 解析 [-]h[:mm[:ss]] 形式的偏移，返回秒数
+func hms(s string) int {
+    sign := 1
+    if strings.HasPrefix(s, "-") {
+        sign, s = -1, s[1:]
+    }
+    secs := 0
+    for i, p := range strings.SplitN(s, ":", 3) {
+        n, err := strconv.Atoi(p)
+        if err != nil {
+            log.Fatalf("gen: 无法识别的偏移 %q", s)
+        }
+        secs += n * [...]int{3600, 60, 1}[i]
+    }
+    return sign * secs
+}
+
+// iso6709 解析 ±DDMM±DDDMM 或 ±DDMMSS±DDDMMSS 形式的坐标，返回纬度与经度 (度)
+func iso6709(s string) (lat, lon float64) {
+    i := strings.IndexAny(s[1:], "+-") + 1
+    if i == 0 {
+        log.Fatalf("gen: 无法识别的坐标 %q", s)
+    }
+    return degrees(s[:i], 2), degrees(s[i:], 3)
+}
+
+func degrees(s string, width int) float64 {
+    sign, digits := 1.0, s[1:]
+    if s[0] == '-' {
+        sign = -1
+    }
+    v := 0.0
+    for i, unit := 0, 1.0; len(digits) > 0; i, unit = i+1, unit*60 {
+        w := 2
+        if i == 0 {
+            w = width
+        }
+        n, err := strconv.Atoi(digits[:w])
+        if err != nil {
+            log.Fatalf("gen: 无法识别的坐标 %q", s)
+        }
+        v, digits = v+float64(n)/unit, digits[w:]
+    }
+    return sign * v
+}
+
+func coord(v float64) string {
+    return strconv.FormatFloat(math.Round(v*1e6)/1e6, 'f', -1, 64)
+}
+
+func strs(s []string) string {
+    return fmt.Sprintf("%#v", s)
+}
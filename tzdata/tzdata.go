@@ -0,0 +1,88 @@
+// Package tzdata 嵌入时区数据库并提供时区目录。
+//
+// 导入本包后，aeon.LoadZone 与 time.LoadLocation 在系统缺少 zoneinfo (如精简的容器镜像) 时
+// 使用嵌入的数据 (标准库 time/tzdata，约增加 450KB)：
+//
+//	import _ "github.com/baagod/aeon/tzdata"
+//
+// 时区目录 (Zones、Lookup、ByCountry) 由 source 目录中的 zone1970.tab 与 tzdata.zi 经 go generate 生成，
+// 列出所有规范时区的国家代码、坐标、别名与当前的标准时间偏移，版本见 Version。
+//
+// 嵌入的时区数据与目录相互独立：前者的版本取决于构建所用的 Go 工具链，Version 只描述目录。
+// 两者版本不同时，目录中的时区仍可加载，但偏移规则以嵌入的数据为准。
+package tzdata
+
+//go:generate go run gen.go
+
+import (
+    _ "time/tzdata"
+)
+
+// Zone 规范时区的目录信息
+type Zone struct {
+    Name      string   // IANA 名称，如 "America/New_York"
+    Countries []string // ISO 3166 国家代码，首个为主要国家，其余按代码排列
+    Lat, Lon  float64  // 代表城市的纬度与经度 (度)，北纬与东经为正
+    Comment   string   // 说明，如 "Eastern (most areas)"，同一国家只有一个时区时为空
+    Aliases   []string // 链接到该时区的名称，如 "US/Eastern"
+    StdOffset int      // 当前规则的标准时间相对 UTC 的偏移 (秒)，按 tz 数据库的定义 (如 Europe/Dublin 为 +1 小时)
+}
+
+var (
+    byName    map[string]int // 名称与别名 → zones 中的序号
+    byCountry map[string][]int
+)
+
+func init() {
+    byName = make(map[string]int, len(zones)*2)
+    byCountry = make(map[string][]int)
+    for i, z := range zones {
+        byName[z.Name] = i
+        for _, a := range z.Aliases {
+            byName[a] = i
+        }
+        for _, c := range z.Countries {
+            byCountry[c] = append(byCountry[c], i)
+        }
+    }
+}
+
+// Zones 返回所有规范时区，按名称排列
+func Zones() []Zone {
+    list := make([]Zone, len(zones))
+    for i, z := range zones {
+        list[i] = z.clone()
+    }
+    return list
+}
+
+// Lookup 按名称或别名查找规范时区，如 Lookup("US/Eastern") 返回 America/New_York
+func Lookup(name string) (Zone, bool) {
+    i, ok := byName[name]
+    if !ok {
+        return Zone{}, false
+    }
+    return zones[i].clone(), true
+}
+
+// ByCountry 返回使用指定 ISO 3166 国家代码 (如 "US") 的规范时区，按名称排列
+func ByCountry(code string) []Zone {
+    idx := byCountry[code]
+    if len(idx) == 0 {
+        return nil
+    }
+    list := make([]Zone, len(idx))
+    for i, j := range idx {
+        list[i] = zones[j].clone()
+    }
+    return list
+}
+
+// clone 返回 z 的副本，Countries 与 Aliases 不与目录共享，调用方修改不影响目录
+func (z Zone) clone() Zone {
+    z.Countries = append([]string(nil), z.Countries...)
+    if z.Aliases != nil {
+        z.Aliases = append([]string(nil), z.Aliases...)
+    }
+    return z
+}
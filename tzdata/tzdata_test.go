@@ -0,0 +1,97 @@
+package tzdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/baagod/aeon"
+)
+
+func TestCatalog(t *testing.T) {
+	ny, ok := Lookup("America/New_York")
+	if !ok || ny.Countries[0] != "US" || ny.StdOffset != -5*3600 || ny.Comment != "Eastern (most areas)" {
+		t.Fatalf("Lookup: got %+v, %v", ny, ok)
+	}
+	if ny.Lat < 40.7 || ny.Lat > 40.72 || ny.Lon < -74.01 || ny.Lon > -74 {
+		t.Errorf("坐标: got %v, %v", ny.Lat, ny.Lon)
+	}
+
+	for alias, want := range map[string]string{
+		"US/Eastern":     "America/New_York",
+		"Asia/Calcutta":  "Asia/Kolkata",
+		"PRC":            "Asia/Shanghai",
+		"Asia/Chongqing": "Asia/Shanghai",
+	} {
+		if z, ok := Lookup(alias); !ok || z.Name != want {
+			t.Errorf("Lookup(%s): got %s, %v", alias, z.Name, ok)
+		}
+	}
+	if _, ok := Lookup("Mars/Olympus_Mons"); ok {
+		t.Error("Lookup: 不存在的时区")
+	}
+
+	for name, off := range map[string]int{
+		"Asia/Shanghai":       8 * 3600,
+		"Asia/Kolkata":        5*3600 + 1800,
+		"Asia/Kathmandu":      5*3600 + 2700,
+		"America/St_Johns":    -(3*3600 + 1800),
+		"Australia/Lord_Howe": 10*3600 + 1800,
+		"Europe/Dublin":       3600, // tz 数据库以夏季的 IST 为标准时间
+	} {
+		if z, ok := Lookup(name); !ok || z.StdOffset != off {
+			t.Errorf("StdOffset(%s): got %d, want %d", name, z.StdOffset, off)
+		}
+	}
+
+	us := ByCountry("US")
+	if len(us) < 20 || us[0].Name > us[len(us)-1].Name {
+		t.Errorf("ByCountry(US): got %d zones", len(us))
+	}
+	if berlin := ByCountry("NO"); len(berlin) == 0 {
+		t.Error("ByCountry(NO): 多国共用的时区")
+	}
+	if ByCountry("XX") != nil {
+		t.Error("ByCountry(XX): want nil")
+	}
+
+	all := Zones()
+	all[0].Name = "changed"
+	all[0].Countries[0] = "XX"
+	if z := Zones()[0]; z.Name == "changed" || z.Countries[0] == "XX" {
+		t.Error("Zones: 应返回副本")
+	}
+	ny.Aliases[0] = "changed"
+	if z, _ := Lookup("America/New_York"); z.Aliases[0] == "changed" {
+		t.Error("Lookup: 应返回副本")
+	}
+	us[0].Countries[0] = "XX"
+	if ByCountry("US")[0].Countries[0] == "XX" {
+		t.Error("ByCountry: 应返回副本")
+	}
+}
+
+// TestZones 目录中的每个时区都能加载，且在目录版本所在的一年内有处于标准偏移的时刻
+func TestZones(t *testing.T) {
+	ref := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC) // 固定参考时间，结果不随运行日期变化
+	zones := Zones()
+	for i, z := range zones {
+		if i > 0 && zones[i-1].Name >= z.Name {
+			t.Errorf("Zones: %s 未按名称排列", z.Name)
+		}
+
+		loc, err := aeon.LoadZone(z.Name)
+		if err != nil {
+			t.Errorf("LoadZone(%s): %v", z.Name, err)
+			continue
+		}
+
+		found := false
+		for d, at := 0, ref; d < 366 && !found; d++ {
+			_, off := at.AddDate(0, 0, d).In(loc).Zone()
+			found = off == z.StdOffset
+		}
+		if !found {
+			t.Errorf("%s: 一年内没有偏移为 %d 的时刻", z.Name, z.StdOffset)
+		}
+	}
+}
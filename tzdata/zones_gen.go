@@ -0,0 +1,322 @@
+// Code generated by gen.go from tzdata 2025b; DO NOT EDIT.
+
+package tzdata
+
+// Version 生成时区目录的 tz 数据库版本。
+// 它只描述目录，嵌入的时区数据来自构建所用 Go 工具链的 time/tzdata，版本可能不同。
+const Version = "2025b"
+
+var zones = [...]Zone{
+	{Name: "Africa/Abidjan", Countries: []string{"CI", "BF", "GH", "GM", "GN", "IS", "ML", "MR", "SH", "SL", "SN", "TG"}, Lat: 5.316667, Lon: -4.033333, Aliases: []string{"Africa/Timbuktu", "Iceland"}, StdOffset: 0},
+	{Name: "Africa/Algiers", Countries: []string{"DZ"}, Lat: 36.783333, Lon: 3.05, StdOffset: 3600},
+	{Name: "Africa/Bissau", Countries: []string{"GW"}, Lat: 11.85, Lon: -15.583333, StdOffset: 0},
+	{Name: "Africa/Cairo", Countries: []string{"EG"}, Lat: 30.05, Lon: 31.25, Aliases: []string{"Egypt"}, StdOffset: 7200},
+	{Name: "Africa/Casablanca", Countries: []string{"MA"}, Lat: 33.65, Lon: -7.583333, StdOffset: 3600},
+	{Name: "Africa/Ceuta", Countries: []string{"ES"}, Lat: 35.883333, Lon: -5.316667, Comment: "Ceuta, Melilla", StdOffset: 3600},
+	{Name: "Africa/El_Aaiun", Countries: []string{"EH"}, Lat: 27.15, Lon: -13.2, StdOffset: 3600},
+	{Name: "Africa/Johannesburg", Countries: []string{"ZA", "LS", "SZ"}, Lat: -26.25, Lon: 28, StdOffset: 7200},
+	{Name: "Africa/Juba", Countries: []string{"SS"}, Lat: 4.85, Lon: 31.616667, StdOffset: 7200},
+	{Name: "Africa/Khartoum", Countries: []string{"SD"}, Lat: 15.6, Lon: 32.533333, StdOffset: 7200},
+	{Name: "Africa/Lagos", Countries: []string{"NG", "AO", "BJ", "CD", "CF", "CG", "CM", "GA", "GQ", "NE"}, Lat: 6.45, Lon: 3.4, Comment: "West Africa Time", StdOffset: 3600},
+	{Name: "Africa/Maputo", Countries: []string{"MZ", "BI", "BW", "CD", "MW", "RW", "ZM", "ZW"}, Lat: -25.966667, Lon: 32.583333, Comment: "Central Africa Time", StdOffset: 7200},
+	{Name: "Africa/Monrovia", Countries: []string{"LR"}, Lat: 6.3, Lon: -10.783333, StdOffset: 0},
+	{Name: "Africa/Nairobi", Countries: []string{"KE", "DJ", "ER", "ET", "KM", "MG", "SO", "TZ", "UG", "YT"}, Lat: -1.283333, Lon: 36.816667, Aliases: []string{"Africa/Asmera"}, StdOffset: 10800},
+	{Name: "Africa/Ndjamena", Countries: []string{"TD"}, Lat: 12.116667, Lon: 15.05, StdOffset: 3600},
+	{Name: "Africa/Sao_Tome", Countries: []string{"ST"}, Lat: 0.333333, Lon: 6.733333, StdOffset: 0},
+	{Name: "Africa/Tripoli", Countries: []string{"LY"}, Lat: 32.9, Lon: 13.183333, Aliases: []string{"Libya"}, StdOffset: 7200},
+	{Name: "Africa/Tunis", Countries: []string{"TN"}, Lat: 36.8, Lon: 10.183333, StdOffset: 3600},
+	{Name: "Africa/Windhoek", Countries: []string{"NA"}, Lat: -22.566667, Lon: 17.1, StdOffset: 7200},
+	{Name: "America/Adak", Countries: []string{"US"}, Lat: 51.88, Lon: -176.658056, Comment: "Alaska - western Aleutians", Aliases: []string{"America/Atka", "US/Aleutian"}, StdOffset: -36000},
+	{Name: "America/Anchorage", Countries: []string{"US"}, Lat: 61.218056, Lon: -149.900278, Comment: "Alaska (most areas)", Aliases: []string{"US/Alaska"}, StdOffset: -32400},
+	{Name: "America/Araguaina", Countries: []string{"BR"}, Lat: -7.2, Lon: -48.2, Comment: "Tocantins", StdOffset: -10800},
+	{Name: "America/Argentina/Buenos_Aires", Countries: []string{"AR"}, Lat: -34.6, Lon: -58.45, Comment: "Buenos Aires (BA, CF)", Aliases: []string{"America/Buenos_Aires"}, StdOffset: -10800},
+	{Name: "America/Argentina/Catamarca", Countries: []string{"AR"}, Lat: -28.466667, Lon: -65.783333, Comment: "Catamarca (CT), Chubut (CH)", Aliases: []string{"America/Argentina/ComodRivadavia", "America/Catamarca"}, StdOffset: -10800},
+	{Name: "America/Argentina/Cordoba", Countries: []string{"AR"}, Lat: -31.4, Lon: -64.183333, Comment: "most areas: CB, CC, CN, ER, FM, MN, SE, SF", Aliases: []string{"America/Cordoba", "America/Rosario"}, StdOffset: -10800},
+	{Name: "America/Argentina/Jujuy", Countries: []string{"AR"}, Lat: -24.183333, Lon: -65.3, Comment: "Jujuy (JY)", Aliases: []string{"America/Jujuy"}, StdOffset: -10800},
+	{Name: "America/Argentina/La_Rioja", Countries: []string{"AR"}, Lat: -29.433333, Lon: -66.85, Comment: "La Rioja (LR)", StdOffset: -10800},
+	{Name: "America/Argentina/Mendoza", Countries: []string{"AR"}, Lat: -32.883333, Lon: -68.816667, Comment: "Mendoza (MZ)", Aliases: []string{"America/Mendoza"}, StdOffset: -10800},
+	{Name: "America/Argentina/Rio_Gallegos", Countries: []string{"AR"}, Lat: -51.633333, Lon: -69.216667, Comment: "Santa Cruz (SC)", StdOffset: -10800},
+	{Name: "America/Argentina/Salta", Countries: []string{"AR"}, Lat: -24.783333, Lon: -65.416667, Comment: "Salta (SA, LP, NQ, RN)", StdOffset: -10800},
+	{Name: "America/Argentina/San_Juan", Countries: []string{"AR"}, Lat: -31.533333, Lon: -68.516667, Comment: "San Juan (SJ)", StdOffset: -10800},
+	{Name: "America/Argentina/San_Luis", Countries: []string{"AR"}, Lat: -33.316667, Lon: -66.35, Comment: "San Luis (SL)", StdOffset: -10800},
+	{Name: "America/Argentina/Tucuman", Countries: []string{"AR"}, Lat: -26.816667, Lon: -65.216667, Comment: "Tucumán (TM)", StdOffset: -10800},
+	{Name: "America/Argentina/Ushuaia", Countries: []string{"AR"}, Lat: -54.8, Lon: -68.3, Comment: "Tierra del Fuego (TF)", StdOffset: -10800},
+	{Name: "America/Asuncion", Countries: []string{"PY"}, Lat: -25.266667, Lon: -57.666667, StdOffset: -10800},
+	{Name: "America/Bahia", Countries: []string{"BR"}, Lat: -12.983333, Lon: -38.516667, Comment: "Bahia", StdOffset: -10800},
+	{Name: "America/Bahia_Banderas", Countries: []string{"MX"}, Lat: 20.8, Lon: -105.25, Comment: "Bahía de Banderas", StdOffset: -21600},
+	{Name: "America/Barbados", Countries: []string{"BB"}, Lat: 13.1, Lon: -59.616667, StdOffset: -14400},
+	{Name: "America/Belem", Countries: []string{"BR"}, Lat: -1.45, Lon: -48.483333, Comment: "Pará (east), Amapá", StdOffset: -10800},
+	{Name: "America/Belize", Countries: []string{"BZ"}, Lat: 17.5, Lon: -88.2, StdOffset: -21600},
+	{Name: "America/Boa_Vista", Countries: []string{"BR"}, Lat: 2.816667, Lon: -60.666667, Comment: "Roraima", StdOffset: -14400},
+	{Name: "America/Bogota", Countries: []string{"CO"}, Lat: 4.6, Lon: -74.083333, StdOffset: -18000},
+	{Name: "America/Boise", Countries: []string{"US"}, Lat: 43.613611, Lon: -116.2025, Comment: "Mountain - ID (south), OR (east)", StdOffset: -25200},
+	{Name: "America/Cambridge_Bay", Countries: []string{"CA"}, Lat: 69.113889, Lon: -105.052778, Comment: "Mountain - NU (west)", StdOffset: -25200},
+	{Name: "America/Campo_Grande", Countries: []string{"BR"}, Lat: -20.45, Lon: -54.616667, Comment: "Mato Grosso do Sul", StdOffset: -14400},
+	{Name: "America/Cancun", Countries: []string{"MX"}, Lat: 21.083333, Lon: -86.766667, Comment: "Quintana Roo", StdOffset: -18000},
+	{Name: "America/Caracas", Countries: []string{"VE"}, Lat: 10.5, Lon: -66.933333, StdOffset: -14400},
+	{Name: "America/Cayenne", Countries: []string{"GF"}, Lat: 4.933333, Lon: -52.333333, StdOffset: -10800},
+	{Name: "America/Chicago", Countries: []string{"US"}, Lat: 41.85, Lon: -87.65, Comment: "Central (most areas)", Aliases: []string{"US/Central"}, StdOffset: -21600},
+	{Name: "America/Chihuahua", Countries: []string{"MX"}, Lat: 28.633333, Lon: -106.083333, Comment: "Chihuahua (most areas)", StdOffset: -21600},
+	{Name: "America/Ciudad_Juarez", Countries: []string{"MX"}, Lat: 31.733333, Lon: -106.483333, Comment: "Chihuahua (US border - west)", StdOffset: -25200},
+	{Name: "America/Costa_Rica", Countries: []string{"CR"}, Lat: 9.933333, Lon: -84.083333, StdOffset: -21600},
+	{Name: "America/Coyhaique", Countries: []string{"CL"}, Lat: -45.566667, Lon: -72.066667, Comment: "Aysén Region", StdOffset: -10800},
+	{Name: "America/Cuiaba", Countries: []string{"BR"}, Lat: -15.583333, Lon: -56.083333, Comment: "Mato Grosso", StdOffset: -14400},
+	{Name: "America/Danmarkshavn", Countries: []string{"GL"}, Lat: 76.766667, Lon: -18.666667, Comment: "National Park (east coast)", StdOffset: 0},
+	{Name: "America/Dawson", Countries: []string{"CA"}, Lat: 64.066667, Lon: -139.416667, Comment: "MST - Yukon (west)", StdOffset: -25200},
+	{Name: "America/Dawson_Creek", Countries: []string{"CA"}, Lat: 55.766667, Lon: -120.233333, Comment: "MST - BC (Dawson Cr, Ft St John)", StdOffset: -25200},
+	{Name: "America/Denver", Countries: []string{"US"}, Lat: 39.739167, Lon: -104.984167, Comment: "Mountain (most areas)", Aliases: []string{"America/Shiprock", "Navajo", "US/Mountain"}, StdOffset: -25200},
+	{Name: "America/Detroit", Countries: []string{"US"}, Lat: 42.331389, Lon: -83.045833, Comment: "Eastern - MI (most areas)", Aliases: []string{"US/Michigan"}, StdOffset: -18000},
+	{Name: "America/Edmonton", Countries: []string{"CA"}, Lat: 53.55, Lon: -113.466667, Comment: "Mountain - AB, BC(E), NT(E), SK(W)", Aliases: []string{"America/Yellowknife", "Canada/Mountain"}, StdOffset: -25200},
+	{Name: "America/Eirunepe", Countries: []string{"BR"}, Lat: -6.666667, Lon: -69.866667, Comment: "Amazonas (west)", StdOffset: -18000},
+	{Name: "America/El_Salvador", Countries: []string{"SV"}, Lat: 13.7, Lon: -89.2, StdOffset: -21600},
+	{Name: "America/Fort_Nelson", Countries: []string{"CA"}, Lat: 58.8, Lon: -122.7, Comment: "MST - BC (Ft Nelson)", StdOffset: -25200},
+	{Name: "America/Fortaleza", Countries: []string{"BR"}, Lat: -3.716667, Lon: -38.5, Comment: "Brazil (northeast: MA, PI, CE, RN, PB)", StdOffset: -10800},
+	{Name: "America/Glace_Bay", Countries: []string{"CA"}, Lat: 46.2, Lon: -59.95, Comment: "Atlantic - NS (Cape Breton)", StdOffset: -14400},
+	{Name: "America/Goose_Bay", Countries: []string{"CA"}, Lat: 53.333333, Lon: -60.416667, Comment: "Atlantic - Labrador (most areas)", StdOffset: -14400},
+	{Name: "America/Grand_Turk", Countries: []string{"TC"}, Lat: 21.466667, Lon: -71.133333, StdOffset: -18000},
+	{Name: "America/Guatemala", Countries: []string{"GT"}, Lat: 14.633333, Lon: -90.516667, StdOffset: -21600},
+	{Name: "America/Guayaquil", Countries: []string{"EC"}, Lat: -2.166667, Lon: -79.833333, Comment: "Ecuador (mainland)", StdOffset: -18000},
+	{Name: "America/Guyana", Countries: []string{"GY"}, Lat: 6.8, Lon: -58.166667, StdOffset: -14400},
+	{Name: "America/Halifax", Countries: []string{"CA"}, Lat: 44.65, Lon: -63.6, Comment: "Atlantic - NS (most areas), PE", Aliases: []string{"Canada/Atlantic"}, StdOffset: -14400},
+	{Name: "America/Havana", Countries: []string{"CU"}, Lat: 23.133333, Lon: -82.366667, Aliases: []string{"Cuba"}, StdOffset: -18000},
+	{Name: "America/Hermosillo", Countries: []string{"MX"}, Lat: 29.066667, Lon: -110.966667, Comment: "Sonora", StdOffset: -25200},
+	{Name: "America/Indiana/Indianapolis", Countries: []string{"US"}, Lat: 39.768333, Lon: -86.158056, Comment: "Eastern - IN (most areas)", Aliases: []string{"America/Fort_Wayne", "America/Indianapolis", "US/East-Indiana"}, StdOffset: -18000},
+	{Name: "America/Indiana/Knox", Countries: []string{"US"}, Lat: 41.295833, Lon: -86.625, Comment: "Central - IN (Starke)", Aliases: []string{"America/Knox_IN", "US/Indiana-Starke"}, StdOffset: -21600},
+	{Name: "America/Indiana/Marengo", Countries: []string{"US"}, Lat: 38.375556, Lon: -86.344722, Comment: "Eastern - IN (Crawford)", StdOffset: -18000},
+	{Name: "America/Indiana/Petersburg", Countries: []string{"US"}, Lat: 38.491944, Lon: -87.278611, Comment: "Eastern - IN (Pike)", StdOffset: -18000},
+	{Name: "America/Indiana/Tell_City", Countries: []string{"US"}, Lat: 37.953056, Lon: -86.761389, Comment: "Central - IN (Perry)", StdOffset: -21600},
+	{Name: "America/Indiana/Vevay", Countries: []string{"US"}, Lat: 38.747778, Lon: -85.067222, Comment: "Eastern - IN (Switzerland)", StdOffset: -18000},
+	{Name: "America/Indiana/Vincennes", Countries: []string{"US"}, Lat: 38.677222, Lon: -87.528611, Comment: "Eastern - IN (Da, Du, K, Mn)", StdOffset: -18000},
+	{Name: "America/Indiana/Winamac", Countries: []string{"US"}, Lat: 41.051389, Lon: -86.603056, Comment: "Eastern - IN (Pulaski)", StdOffset: -18000},
+	{Name: "America/Inuvik", Countries: []string{"CA"}, Lat: 68.349722, Lon: -133.716667, Comment: "Mountain - NT (west)", StdOffset: -25200},
+	{Name: "America/Iqaluit", Countries: []string{"CA"}, Lat: 63.733333, Lon: -68.466667, Comment: "Eastern - NU (most areas)", Aliases: []string{"America/Pangnirtung"}, StdOffset: -18000},
+	{Name: "America/Jamaica", Countries: []string{"JM"}, Lat: 17.968056, Lon: -76.793333, Aliases: []string{"Jamaica"}, StdOffset: -18000},
+	{Name: "America/Juneau", Countries: []string{"US"}, Lat: 58.301944, Lon: -134.419722, Comment: "Alaska - Juneau area", StdOffset: -32400},
+	{Name: "America/Kentucky/Louisville", Countries: []string{"US"}, Lat: 38.254167, Lon: -85.759444, Comment: "Eastern - KY (Louisville area)", Aliases: []string{"America/Louisville"}, StdOffset: -18000},
+	{Name: "America/Kentucky/Monticello", Countries: []string{"US"}, Lat: 36.829722, Lon: -84.849167, Comment: "Eastern - KY (Wayne)", StdOffset: -18000},
+	{Name: "America/La_Paz", Countries: []string{"BO"}, Lat: -16.5, Lon: -68.15, StdOffset: -14400},
+	{Name: "America/Lima", Countries: []string{"PE"}, Lat: -12.05, Lon: -77.05, StdOffset: -18000},
+	{Name: "America/Los_Angeles", Countries: []string{"US"}, Lat: 34.052222, Lon: -118.242778, Comment: "Pacific", Aliases: []string{"US/Pacific"}, StdOffset: -28800},
+	{Name: "America/Maceio", Countries: []string{"BR"}, Lat: -9.666667, Lon: -35.716667, Comment: "Alagoas, Sergipe", StdOffset: -10800},
+	{Name: "America/Managua", Countries: []string{"NI"}, Lat: 12.15, Lon: -86.283333, StdOffset: -21600},
+	{Name: "America/Manaus", Countries: []string{"BR"}, Lat: -3.133333, Lon: -60.016667, Comment: "Amazonas (east)", Aliases: []string{"Brazil/West"}, StdOffset: -14400},
+	{Name: "America/Martinique", Countries: []string{"MQ"}, Lat: 14.6, Lon: -61.083333, StdOffset: -14400},
+	{Name: "America/Matamoros", Countries: []string{"MX"}, Lat: 25.833333, Lon: -97.5, Comment: "Coahuila, Nuevo León, Tamaulipas (US border)", StdOffset: -21600},
+	{Name: "America/Mazatlan", Countries: []string{"MX"}, Lat: 23.216667, Lon: -106.416667, Comment: "Baja California Sur, Nayarit (most areas), Sinaloa", Aliases: []string{"Mexico/BajaSur"}, StdOffset: -25200},
+	{Name: "America/Menominee", Countries: []string{"US"}, Lat: 45.107778, Lon: -87.614167, Comment: "Central - MI (Wisconsin border)", StdOffset: -21600},
+	{Name: "America/Merida", Countries: []string{"MX"}, Lat: 20.966667, Lon: -89.616667, Comment: "Campeche, Yucatán", StdOffset: -21600},
+	{Name: "America/Metlakatla", Countries: []string{"US"}, Lat: 55.126944, Lon: -131.576389, Comment: "Alaska - Annette Island", StdOffset: -32400},
+	{Name: "America/Mexico_City", Countries: []string{"MX"}, Lat: 19.4, Lon: -99.15, Comment: "Central Mexico", Aliases: []string{"Mexico/General"}, StdOffset: -21600},
+	{Name: "America/Miquelon", Countries: []string{"PM"}, Lat: 47.05, Lon: -56.333333, StdOffset: -10800},
+	{Name: "America/Moncton", Countries: []string{"CA"}, Lat: 46.1, Lon: -64.783333, Comment: "Atlantic - New Brunswick", StdOffset: -14400},
+	{Name: "America/Monterrey", Countries: []string{"MX"}, Lat: 25.666667, Lon: -100.316667, Comment: "Durango; Coahuila, Nuevo León, Tamaulipas (most areas)", StdOffset: -21600},
+	{Name: "America/Montevideo", Countries: []string{"UY"}, Lat: -34.909167, Lon: -56.2125, StdOffset: -10800},
+	{Name: "America/New_York", Countries: []string{"US"}, Lat: 40.714167, Lon: -74.006389, Comment: "Eastern (most areas)", Aliases: []string{"US/Eastern"}, StdOffset: -18000},
+	{Name: "America/Nome", Countries: []string{"US"}, Lat: 64.501111, Lon: -165.406389, Comment: "Alaska (west)", StdOffset: -32400},
+	{Name: "America/Noronha", Countries: []string{"BR"}, Lat: -3.85, Lon: -32.416667, Comment: "Atlantic islands", Aliases: []string{"Brazil/DeNoronha"}, StdOffset: -7200},
+	{Name: "America/North_Dakota/Beulah", Countries: []string{"US"}, Lat: 47.264167, Lon: -101.777778, Comment: "Central - ND (Mercer)", StdOffset: -21600},
+	{Name: "America/North_Dakota/Center", Countries: []string{"US"}, Lat: 47.116389, Lon: -101.299167, Comment: "Central - ND (Oliver)", StdOffset: -21600},
+	{Name: "America/North_Dakota/New_Salem", Countries: []string{"US"}, Lat: 46.845, Lon: -101.410833, Comment: "Central - ND (Morton rural)", StdOffset: -21600},
+	{Name: "America/Nuuk", Countries: []string{"GL"}, Lat: 64.183333, Lon: -51.733333, Comment: "most of Greenland", Aliases: []string{"America/Godthab"}, StdOffset: -7200},
+	{Name: "America/Ojinaga", Countries: []string{"MX"}, Lat: 29.566667, Lon: -104.416667, Comment: "Chihuahua (US border - east)", StdOffset: -21600},
+	{Name: "America/Panama", Countries: []string{"PA", "CA", "KY"}, Lat: 8.966667, Lon: -79.533333, Comment: "EST - ON (Atikokan), NU (Coral H)", Aliases: []string{"America/Coral_Harbour"}, StdOffset: -18000},
+	{Name: "America/Paramaribo", Countries: []string{"SR"}, Lat: 5.833333, Lon: -55.166667, StdOffset: -10800},
+	{Name: "America/Phoenix", Countries: []string{"US", "CA"}, Lat: 33.448333, Lon: -112.073333, Comment: "MST - AZ (most areas), Creston BC", Aliases: []string{"US/Arizona"}, StdOffset: -25200},
+	{Name: "America/Port-au-Prince", Countries: []string{"HT"}, Lat: 18.533333, Lon: -72.333333, StdOffset: -18000},
+	{Name: "America/Porto_Velho", Countries: []string{"BR"}, Lat: -8.766667, Lon: -63.9, Comment: "Rondônia", StdOffset: -14400},
+	{Name: "America/Puerto_Rico", Countries: []string{"PR", "AG", "CA", "AI", "AW", "BL", "BQ", "CW", "DM", "GD", "GP", "KN", "LC", "MF", "MS", "SX", "TT", "VC", "VG", "VI"}, Lat: 18.468333, Lon: -66.106111, Comment: "AST - QC (Lower North Shore)", Aliases: []string{"America/Kralendijk", "America/Lower_Princes", "America/Marigot", "America/St_Barthelemy", "America/Virgin"}, StdOffset: -14400},
+	{Name: "America/Punta_Arenas", Countries: []string{"CL"}, Lat: -53.15, Lon: -70.916667, Comment: "Magallanes Region", StdOffset: -10800},
+	{Name: "America/Rankin_Inlet", Countries: []string{"CA"}, Lat: 62.816667, Lon: -92.083056, Comment: "Central - NU (central)", StdOffset: -21600},
+	{Name: "America/Recife", Countries: []string{"BR"}, Lat: -8.05, Lon: -34.9, Comment: "Pernambuco", StdOffset: -10800},
+	{Name: "America/Regina", Countries: []string{"CA"}, Lat: 50.4, Lon: -104.65, Comment: "CST - SK (most areas)", Aliases: []string{"Canada/Saskatchewan"}, StdOffset: -21600},
+	{Name: "America/Resolute", Countries: []string{"CA"}, Lat: 74.695556, Lon: -94.829167, Comment: "Central - NU (Resolute)", StdOffset: -21600},
+	{Name: "America/Rio_Branco", Countries: []string{"BR"}, Lat: -9.966667, Lon: -67.8, Comment: "Acre", Aliases: []string{"America/Porto_Acre", "Brazil/Acre"}, StdOffset: -18000},
+	{Name: "America/Santarem", Countries: []string{"BR"}, Lat: -2.433333, Lon: -54.866667, Comment: "Pará (west)", StdOffset: -10800},
+	{Name: "America/Santiago", Countries: []string{"CL"}, Lat: -33.45, Lon: -70.666667, Comment: "most of Chile", Aliases: []string{"Chile/Continental"}, StdOffset: -14400},
+	{Name: "America/Santo_Domingo", Countries: []string{"DO"}, Lat: 18.466667, Lon: -69.9, StdOffset: -14400},
+	{Name: "America/Sao_Paulo", Countries: []string{"BR"}, Lat: -23.533333, Lon: -46.616667, Comment: "Brazil (southeast: GO, DF, MG, ES, RJ, SP, PR, SC, RS)", Aliases: []string{"Brazil/East"}, StdOffset: -10800},
+	{Name: "America/Scoresbysund", Countries: []string{"GL"}, Lat: 70.483333, Lon: -21.966667, Comment: "Scoresbysund/Ittoqqortoormiit", StdOffset: -7200},
+	{Name: "America/Sitka", Countries: []string{"US"}, Lat: 57.176389, Lon: -135.301944, Comment: "Alaska - Sitka area", StdOffset: -32400},
+	{Name: "America/St_Johns", Countries: []string{"CA"}, Lat: 47.566667, Lon: -52.716667, Comment: "Newfoundland, Labrador (SE)", Aliases: []string{"Canada/Newfoundland"}, StdOffset: -12600},
+	{Name: "America/Swift_Current", Countries: []string{"CA"}, Lat: 50.283333, Lon: -107.833333, Comment: "CST - SK (midwest)", StdOffset: -21600},
+	{Name: "America/Tegucigalpa", Countries: []string{"HN"}, Lat: 14.1, Lon: -87.216667, StdOffset: -21600},
+	{Name: "America/Thule", Countries: []string{"GL"}, Lat: 76.566667, Lon: -68.783333, Comment: "Thule/Pituffik", StdOffset: -14400},
+	{Name: "America/Tijuana", Countries: []string{"MX"}, Lat: 32.533333, Lon: -117.016667, Comment: "Baja California", Aliases: []string{"America/Ensenada", "America/Santa_Isabel", "Mexico/BajaNorte"}, StdOffset: -28800},
+	{Name: "America/Toronto", Countries: []string{"CA", "BS"}, Lat: 43.65, Lon: -79.383333, Comment: "Eastern - ON & QC (most areas)", Aliases: []string{"America/Montreal", "America/Nipigon", "America/Thunder_Bay", "Canada/Eastern"}, StdOffset: -18000},
+	{Name: "America/Vancouver", Countries: []string{"CA"}, Lat: 49.266667, Lon: -123.116667, Comment: "Pacific - BC (most areas)", Aliases: []string{"Canada/Pacific"}, StdOffset: -28800},
+	{Name: "America/Whitehorse", Countries: []string{"CA"}, Lat: 60.716667, Lon: -135.05, Comment: "MST - Yukon (east)", Aliases: []string{"Canada/Yukon"}, StdOffset: -25200},
+	{Name: "America/Winnipeg", Countries: []string{"CA"}, Lat: 49.883333, Lon: -97.15, Comment: "Central - ON (west), Manitoba", Aliases: []string{"America/Rainy_River", "Canada/Central"}, StdOffset: -21600},
+	{Name: "America/Yakutat", Countries: []string{"US"}, Lat: 59.546944, Lon: -139.727222, Comment: "Alaska - Yakutat", StdOffset: -32400},
+	{Name: "Antarctica/Casey", Countries: []string{"AQ"}, Lat: -66.283333, Lon: 110.516667, Comment: "Casey", StdOffset: 28800},
+	{Name: "Antarctica/Davis", Countries: []string{"AQ"}, Lat: -68.583333, Lon: 77.966667, Comment: "Davis", StdOffset: 25200},
+	{Name: "Antarctica/Macquarie", Countries: []string{"AU"}, Lat: -54.5, Lon: 158.95, Comment: "Macquarie Island", StdOffset: 36000},
+	{Name: "Antarctica/Mawson", Countries: []string{"AQ"}, Lat: -67.6, Lon: 62.883333, Comment: "Mawson", StdOffset: 18000},
+	{Name: "Antarctica/Palmer", Countries: []string{"AQ"}, Lat: -64.8, Lon: -64.1, Comment: "Palmer", StdOffset: -10800},
+	{Name: "Antarctica/Rothera", Countries: []string{"AQ"}, Lat: -67.566667, Lon: -68.133333, Comment: "Rothera", StdOffset: -10800},
+	{Name: "Antarctica/Troll", Countries: []string{"AQ"}, Lat: -72.011389, Lon: 2.535, Comment: "Troll", StdOffset: 0},
+	{Name: "Antarctica/Vostok", Countries: []string{"AQ"}, Lat: -78.4, Lon: 106.9, Comment: "Vostok", StdOffset: 18000},
+	{Name: "Asia/Almaty", Countries: []string{"KZ"}, Lat: 43.25, Lon: 76.95, Comment: "most of Kazakhstan", StdOffset: 18000},
+	{Name: "Asia/Amman", Countries: []string{"JO"}, Lat: 31.95, Lon: 35.933333, StdOffset: 10800},
+	{Name: "Asia/Anadyr", Countries: []string{"RU"}, Lat: 64.75, Lon: 177.483333, Comment: "MSK+09 - Bering Sea", StdOffset: 43200},
+	{Name: "Asia/Aqtau", Countries: []string{"KZ"}, Lat: 44.516667, Lon: 50.266667, Comment: "Mangghystaū/Mankistau", StdOffset: 18000},
+	{Name: "Asia/Aqtobe", Countries: []string{"KZ"}, Lat: 50.283333, Lon: 57.166667, Comment: "Aqtöbe/Aktobe", StdOffset: 18000},
+	{Name: "Asia/Ashgabat", Countries: []string{"TM"}, Lat: 37.95, Lon: 58.383333, Aliases: []string{"Asia/Ashkhabad"}, StdOffset: 18000},
+	{Name: "Asia/Atyrau", Countries: []string{"KZ"}, Lat: 47.116667, Lon: 51.933333, Comment: "Atyraū/Atirau/Gur'yev", StdOffset: 18000},
+	{Name: "Asia/Baghdad", Countries: []string{"IQ"}, Lat: 33.35, Lon: 44.416667, StdOffset: 10800},
+	{Name: "Asia/Baku", Countries: []string{"AZ"}, Lat: 40.383333, Lon: 49.85, StdOffset: 14400},
+	{Name: "Asia/Bangkok", Countries: []string{"TH", "CX", "KH", "LA", "VN"}, Lat: 13.75, Lon: 100.516667, Comment: "north Vietnam", StdOffset: 25200},
+	{Name: "Asia/Barnaul", Countries: []string{"RU"}, Lat: 53.366667, Lon: 83.75, Comment: "MSK+04 - Altai", StdOffset: 25200},
+	{Name: "Asia/Beirut", Countries: []string{"LB"}, Lat: 33.883333, Lon: 35.5, StdOffset: 7200},
+	{Name: "Asia/Bishkek", Countries: []string{"KG"}, Lat: 42.9, Lon: 74.6, StdOffset: 21600},
+	{Name: "Asia/Chita", Countries: []string{"RU"}, Lat: 52.05, Lon: 113.466667, Comment: "MSK+06 - Zabaykalsky", StdOffset: 32400},
+	{Name: "Asia/Colombo", Countries: []string{"LK"}, Lat: 6.933333, Lon: 79.85, StdOffset: 19800},
+	{Name: "Asia/Damascus", Countries: []string{"SY"}, Lat: 33.5, Lon: 36.3, StdOffset: 10800},
+	{Name: "Asia/Dhaka", Countries: []string{"BD"}, Lat: 23.716667, Lon: 90.416667, Aliases: []string{"Asia/Dacca"}, StdOffset: 21600},
+	{Name: "Asia/Dili", Countries: []string{"TL"}, Lat: -8.55, Lon: 125.583333, StdOffset: 32400},
+	{Name: "Asia/Dubai", Countries: []string{"AE", "OM", "RE", "SC", "TF"}, Lat: 25.3, Lon: 55.3, Comment: "Crozet", StdOffset: 14400},
+	{Name: "Asia/Dushanbe", Countries: []string{"TJ"}, Lat: 38.583333, Lon: 68.8, StdOffset: 18000},
+	{Name: "Asia/Famagusta", Countries: []string{"CY"}, Lat: 35.116667, Lon: 33.95, Comment: "Northern Cyprus", StdOffset: 7200},
+	{Name: "Asia/Gaza", Countries: []string{"PS"}, Lat: 31.5, Lon: 34.466667, Comment: "Gaza Strip", StdOffset: 7200},
+	{Name: "Asia/Hebron", Countries: []string{"PS"}, Lat: 31.533333, Lon: 35.095, Comment: "West Bank", StdOffset: 7200},
+	{Name: "Asia/Ho_Chi_Minh", Countries: []string{"VN"}, Lat: 10.75, Lon: 106.666667, Comment: "south Vietnam", Aliases: []string{"Asia/Saigon"}, StdOffset: 25200},
+	{Name: "Asia/Hong_Kong", Countries: []string{"HK"}, Lat: 22.283333, Lon: 114.15, Aliases: []string{"Hongkong"}, StdOffset: 28800},
+	{Name: "Asia/Hovd", Countries: []string{"MN"}, Lat: 48.016667, Lon: 91.65, Comment: "Bayan-Ölgii, Hovd, Uvs", StdOffset: 25200},
+	{Name: "Asia/Irkutsk", Countries: []string{"RU"}, Lat: 52.266667, Lon: 104.333333, Comment: "MSK+05 - Irkutsk, Buryatia", StdOffset: 28800},
+	{Name: "Asia/Jakarta", Countries: []string{"ID"}, Lat: -6.166667, Lon: 106.8, Comment: "Java, Sumatra", StdOffset: 25200},
+	{Name: "Asia/Jayapura", Countries: []string{"ID"}, Lat: -2.533333, Lon: 140.7, Comment: "New Guinea (West Papua / Irian Jaya), Malukus/Moluccas", StdOffset: 32400},
+	{Name: "Asia/Jerusalem", Countries: []string{"IL"}, Lat: 31.780556, Lon: 35.223889, Aliases: []string{"Asia/Tel_Aviv", "Israel"}, StdOffset: 7200},
+	{Name: "Asia/Kabul", Countries: []string{"AF"}, Lat: 34.516667, Lon: 69.2, StdOffset: 16200},
+	{Name: "Asia/Kamchatka", Countries: []string{"RU"}, Lat: 53.016667, Lon: 158.65, Comment: "MSK+09 - Kamchatka", StdOffset: 43200},
+	{Name: "Asia/Karachi", Countries: []string{"PK"}, Lat: 24.866667, Lon: 67.05, StdOffset: 18000},
+	{Name: "Asia/Kathmandu", Countries: []string{"NP"}, Lat: 27.716667, Lon: 85.316667, Aliases: []string{"Asia/Katmandu"}, StdOffset: 20700},
+	{Name: "Asia/Khandyga", Countries: []string{"RU"}, Lat: 62.656389, Lon: 135.553889, Comment: "MSK+06 - Tomponsky, Ust-Maysky", StdOffset: 32400},
+	{Name: "Asia/Kolkata", Countries: []string{"IN"}, Lat: 22.533333, Lon: 88.366667, Aliases: []string{"Asia/Calcutta"}, StdOffset: 19800},
+	{Name: "Asia/Krasnoyarsk", Countries: []string{"RU"}, Lat: 56.016667, Lon: 92.833333, Comment: "MSK+04 - Krasnoyarsk area", StdOffset: 25200},
+	{Name: "Asia/Kuching", Countries: []string{"MY", "BN"}, Lat: 1.55, Lon: 110.333333, Comment: "Sabah, Sarawak", StdOffset: 28800},
+	{Name: "Asia/Macau", Countries: []string{"MO"}, Lat: 22.197222, Lon: 113.541667, Aliases: []string{"Asia/Macao"}, StdOffset: 28800},
+	{Name: "Asia/Magadan", Countries: []string{"RU"}, Lat: 59.566667, Lon: 150.8, Comment: "MSK+08 - Magadan", StdOffset: 39600},
+	{Name: "Asia/Makassar", Countries: []string{"ID"}, Lat: -5.116667, Lon: 119.4, Comment: "Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)", Aliases: []string{"Asia/Ujung_Pandang"}, StdOffset: 28800},
+	{Name: "Asia/Manila", Countries: []string{"PH"}, Lat: 14.586667, Lon: 120.967778, StdOffset: 28800},
+	{Name: "Asia/Nicosia", Countries: []string{"CY"}, Lat: 35.166667, Lon: 33.366667, Comment: "most of Cyprus", Aliases: []string{"Europe/Nicosia"}, StdOffset: 7200},
+	{Name: "Asia/Novokuznetsk", Countries: []string{"RU"}, Lat: 53.75, Lon: 87.116667, Comment: "MSK+04 - Kemerovo", StdOffset: 25200},
+	{Name: "Asia/Novosibirsk", Countries: []string{"RU"}, Lat: 55.033333, Lon: 82.916667, Comment: "MSK+04 - Novosibirsk", StdOffset: 25200},
+	{Name: "Asia/Omsk", Countries: []string{"RU"}, Lat: 55, Lon: 73.4, Comment: "MSK+03 - Omsk", StdOffset: 21600},
+	{Name: "Asia/Oral", Countries: []string{"KZ"}, Lat: 51.216667, Lon: 51.35, Comment: "West Kazakhstan", StdOffset: 18000},
+	{Name: "Asia/Pontianak", Countries: []string{"ID"}, Lat: -0.033333, Lon: 109.333333, Comment: "Borneo (west, central)", StdOffset: 25200},
+	{Name: "Asia/Pyongyang", Countries: []string{"KP"}, Lat: 39.016667, Lon: 125.75, StdOffset: 32400},
+	{Name: "Asia/Qatar", Countries: []string{"QA", "BH"}, Lat: 25.283333, Lon: 51.533333, StdOffset: 10800},
+	{Name: "Asia/Qostanay", Countries: []string{"KZ"}, Lat: 53.2, Lon: 63.616667, Comment: "Qostanay/Kostanay/Kustanay", StdOffset: 18000},
+	{Name: "Asia/Qyzylorda", Countries: []string{"KZ"}, Lat: 44.8, Lon: 65.466667, Comment: "Qyzylorda/Kyzylorda/Kzyl-Orda", StdOffset: 18000},
+	{Name: "Asia/Riyadh", Countries: []string{"SA", "AQ", "KW", "YE"}, Lat: 24.633333, Lon: 46.716667, Comment: "Syowa", StdOffset: 10800},
+	{Name: "Asia/Sakhalin", Countries: []string{"RU"}, Lat: 46.966667, Lon: 142.7, Comment: "MSK+08 - Sakhalin Island", StdOffset: 39600},
+	{Name: "Asia/Samarkand", Countries: []string{"UZ"}, Lat: 39.666667, Lon: 66.8, Comment: "Uzbekistan (west)", StdOffset: 18000},
+	{Name: "Asia/Seoul", Countries: []string{"KR"}, Lat: 37.55, Lon: 126.966667, Aliases: []string{"ROK"}, StdOffset: 32400},
+	{Name: "Asia/Shanghai", Countries: []string{"CN"}, Lat: 31.233333, Lon: 121.466667, Comment: "Beijing Time", Aliases: []string{"Asia/Chongqing", "Asia/Chungking", "Asia/Harbin", "PRC"}, StdOffset: 28800},
+	{Name: "Asia/Singapore", Countries: []string{"SG", "AQ", "MY"}, Lat: 1.283333, Lon: 103.85, Comment: "peninsular Malaysia, Concordia", Aliases: []string{"Singapore"}, StdOffset: 28800},
+	{Name: "Asia/Srednekolymsk", Countries: []string{"RU"}, Lat: 67.466667, Lon: 153.716667, Comment: "MSK+08 - Sakha (E), N Kuril Is", StdOffset: 39600},
+	{Name: "Asia/Taipei", Countries: []string{"TW"}, Lat: 25.05, Lon: 121.5, Aliases: []string{"ROC"}, StdOffset: 28800},
+	{Name: "Asia/Tashkent", Countries: []string{"UZ"}, Lat: 41.333333, Lon: 69.3, Comment: "Uzbekistan (east)", StdOffset: 18000},
+	{Name: "Asia/Tbilisi", Countries: []string{"GE"}, Lat: 41.716667, Lon: 44.816667, StdOffset: 14400},
+	{Name: "Asia/Tehran", Countries: []string{"IR"}, Lat: 35.666667, Lon: 51.433333, Aliases: []string{"Iran"}, StdOffset: 12600},
+	{Name: "Asia/Thimphu", Countries: []string{"BT"}, Lat: 27.466667, Lon: 89.65, Aliases: []string{"Asia/Thimbu"}, StdOffset: 21600},
+	{Name: "Asia/Tokyo", Countries: []string{"JP", "AU"}, Lat: 35.654444, Lon: 139.744722, Comment: "Eyre Bird Observatory", Aliases: []string{"Japan"}, StdOffset: 32400},
+	{Name: "Asia/Tomsk", Countries: []string{"RU"}, Lat: 56.5, Lon: 84.966667, Comment: "MSK+04 - Tomsk", StdOffset: 25200},
+	{Name: "Asia/Ulaanbaatar", Countries: []string{"MN"}, Lat: 47.916667, Lon: 106.883333, Comment: "most of Mongolia", Aliases: []string{"Asia/Choibalsan", "Asia/Ulan_Bator"}, StdOffset: 28800},
+	{Name: "Asia/Urumqi", Countries: []string{"CN"}, Lat: 43.8, Lon: 87.583333, Comment: "Xinjiang Time", Aliases: []string{"Asia/Kashgar"}, StdOffset: 21600},
+	{Name: "Asia/Ust-Nera", Countries: []string{"RU"}, Lat: 64.560278, Lon: 143.226667, Comment: "MSK+07 - Oymyakonsky", StdOffset: 36000},
+	{Name: "Asia/Vladivostok", Countries: []string{"RU"}, Lat: 43.166667, Lon: 131.933333, Comment: "MSK+07 - Amur River", StdOffset: 36000},
+	{Name: "Asia/Yakutsk", Countries: []string{"RU"}, Lat: 62, Lon: 129.666667, Comment: "MSK+06 - Lena River", StdOffset: 32400},
+	{Name: "Asia/Yangon", Countries: []string{"MM", "CC"}, Lat: 16.783333, Lon: 96.166667, Aliases: []string{"Asia/Rangoon"}, StdOffset: 23400},
+	{Name: "Asia/Yekaterinburg", Countries: []string{"RU"}, Lat: 56.85, Lon: 60.6, Comment: "MSK+02 - Urals", StdOffset: 18000},
+	{Name: "Asia/Yerevan", Countries: []string{"AM"}, Lat: 40.183333, Lon: 44.5, StdOffset: 14400},
+	{Name: "Atlantic/Azores", Countries: []string{"PT"}, Lat: 37.733333, Lon: -25.666667, Comment: "Azores", StdOffset: -3600},
+	{Name: "Atlantic/Bermuda", Countries: []string{"BM"}, Lat: 32.283333, Lon: -64.766667, StdOffset: -14400},
+	{Name: "Atlantic/Canary", Countries: []string{"ES"}, Lat: 28.1, Lon: -15.4, Comment: "Canary Islands", StdOffset: 0},
+	{Name: "Atlantic/Cape_Verde", Countries: []string{"CV"}, Lat: 14.916667, Lon: -23.516667, StdOffset: -3600},
+	{Name: "Atlantic/Faroe", Countries: []string{"FO"}, Lat: 62.016667, Lon: -6.766667, Aliases: []string{"Atlantic/Faeroe"}, StdOffset: 0},
+	{Name: "Atlantic/Madeira", Countries: []string{"PT"}, Lat: 32.633333, Lon: -16.9, Comment: "Madeira Islands", StdOffset: 0},
+	{Name: "Atlantic/South_Georgia", Countries: []string{"GS"}, Lat: -54.266667, Lon: -36.533333, StdOffset: -7200},
+	{Name: "Atlantic/Stanley", Countries: []string{"FK"}, Lat: -51.7, Lon: -57.85, StdOffset: -10800},
+	{Name: "Australia/Adelaide", Countries: []string{"AU"}, Lat: -34.916667, Lon: 138.583333, Comment: "South Australia", Aliases: []string{"Australia/South"}, StdOffset: 34200},
+	{Name: "Australia/Brisbane", Countries: []string{"AU"}, Lat: -27.466667, Lon: 153.033333, Comment: "Queensland (most areas)", Aliases: []string{"Australia/Queensland"}, StdOffset: 36000},
+	{Name: "Australia/Broken_Hill", Countries: []string{"AU"}, Lat: -31.95, Lon: 141.45, Comment: "New South Wales (Yancowinna)", Aliases: []string{"Australia/Yancowinna"}, StdOffset: 34200},
+	{Name: "Australia/Darwin", Countries: []string{"AU"}, Lat: -12.466667, Lon: 130.833333, Comment: "Northern Territory", Aliases: []string{"Australia/North"}, StdOffset: 34200},
+	{Name: "Australia/Eucla", Countries: []string{"AU"}, Lat: -31.716667, Lon: 128.866667, Comment: "Western Australia (Eucla)", StdOffset: 31500},
+	{Name: "Australia/Hobart", Countries: []string{"AU"}, Lat: -42.883333, Lon: 147.316667, Comment: "Tasmania", Aliases: []string{"Australia/Currie", "Australia/Tasmania"}, StdOffset: 36000},
+	{Name: "Australia/Lindeman", Countries: []string{"AU"}, Lat: -20.266667, Lon: 149, Comment: "Queensland (Whitsunday Islands)", StdOffset: 36000},
+	{Name: "Australia/Lord_Howe", Countries: []string{"AU"}, Lat: -31.55, Lon: 159.083333, Comment: "Lord Howe Island", Aliases: []string{"Australia/LHI"}, StdOffset: 37800},
+	{Name: "Australia/Melbourne", Countries: []string{"AU"}, Lat: -37.816667, Lon: 144.966667, Comment: "Victoria", Aliases: []string{"Australia/Victoria"}, StdOffset: 36000},
+	{Name: "Australia/Perth", Countries: []string{"AU"}, Lat: -31.95, Lon: 115.85, Comment: "Western Australia (most areas)", Aliases: []string{"Australia/West"}, StdOffset: 28800},
+	{Name: "Australia/Sydney", Countries: []string{"AU"}, Lat: -33.866667, Lon: 151.216667, Comment: "New South Wales (most areas)", Aliases: []string{"Australia/ACT", "Australia/Canberra", "Australia/NSW"}, StdOffset: 36000},
+	{Name: "Europe/Andorra", Countries: []string{"AD"}, Lat: 42.5, Lon: 1.516667, StdOffset: 3600},
+	{Name: "Europe/Astrakhan", Countries: []string{"RU"}, Lat: 46.35, Lon: 48.05, Comment: "MSK+01 - Astrakhan", StdOffset: 14400},
+	{Name: "Europe/Athens", Countries: []string{"GR"}, Lat: 37.966667, Lon: 23.716667, StdOffset: 7200},
+	{Name: "Europe/Belgrade", Countries: []string{"RS", "BA", "HR", "ME", "MK", "SI"}, Lat: 44.833333, Lon: 20.5, Aliases: []string{"Europe/Podgorica"}, StdOffset: 3600},
+	{Name: "Europe/Berlin", Countries: []string{"DE", "DK", "NO", "SE", "SJ"}, Lat: 52.5, Lon: 13.366667, Comment: "most of Germany", Aliases: []string{"Arctic/Longyearbyen", "Atlantic/Jan_Mayen"}, StdOffset: 3600},
+	{Name: "Europe/Brussels", Countries: []string{"BE", "LU", "NL"}, Lat: 50.833333, Lon: 4.333333, StdOffset: 3600},
+	{Name: "Europe/Bucharest", Countries: []string{"RO"}, Lat: 44.433333, Lon: 26.1, StdOffset: 7200},
+	{Name: "Europe/Budapest", Countries: []string{"HU"}, Lat: 47.5, Lon: 19.083333, StdOffset: 3600},
+	{Name: "Europe/Chisinau", Countries: []string{"MD"}, Lat: 47, Lon: 28.833333, Aliases: []string{"Europe/Tiraspol"}, StdOffset: 7200},
+	{Name: "Europe/Dublin", Countries: []string{"IE"}, Lat: 53.333333, Lon: -6.25, Aliases: []string{"Eire"}, StdOffset: 3600},
+	{Name: "Europe/Gibraltar", Countries: []string{"GI"}, Lat: 36.133333, Lon: -5.35, StdOffset: 3600},
+	{Name: "Europe/Helsinki", Countries: []string{"FI", "AX"}, Lat: 60.166667, Lon: 24.966667, Aliases: []string{"Europe/Mariehamn"}, StdOffset: 7200},
+	{Name: "Europe/Istanbul", Countries: []string{"TR"}, Lat: 41.016667, Lon: 28.966667, Aliases: []string{"Asia/Istanbul", "Turkey"}, StdOffset: 10800},
+	{Name: "Europe/Kaliningrad", Countries: []string{"RU"}, Lat: 54.716667, Lon: 20.5, Comment: "MSK-01 - Kaliningrad", StdOffset: 7200},
+	{Name: "Europe/Kirov", Countries: []string{"RU"}, Lat: 58.6, Lon: 49.65, Comment: "MSK+00 - Kirov", StdOffset: 10800},
+	{Name: "Europe/Kyiv", Countries: []string{"UA"}, Lat: 50.433333, Lon: 30.516667, Comment: "most of Ukraine", Aliases: []string{"Europe/Kiev", "Europe/Uzhgorod", "Europe/Zaporozhye"}, StdOffset: 7200},
+	{Name: "Europe/Lisbon", Countries: []string{"PT"}, Lat: 38.716667, Lon: -9.133333, Comment: "Portugal (mainland)", Aliases: []string{"Portugal"}, StdOffset: 0},
+	{Name: "Europe/London", Countries: []string{"GB", "GG", "IM", "JE"}, Lat: 51.508333, Lon: -0.125278, Aliases: []string{"Europe/Belfast", "GB", "GB-Eire"}, StdOffset: 0},
+	{Name: "Europe/Madrid", Countries: []string{"ES"}, Lat: 40.4, Lon: -3.683333, Comment: "Spain (mainland)", StdOffset: 3600},
+	{Name: "Europe/Malta", Countries: []string{"MT"}, Lat: 35.9, Lon: 14.516667, StdOffset: 3600},
+	{Name: "Europe/Minsk", Countries: []string{"BY"}, Lat: 53.9, Lon: 27.566667, StdOffset: 10800},
+	{Name: "Europe/Moscow", Countries: []string{"RU"}, Lat: 55.755833, Lon: 37.617778, Comment: "MSK+00 - Moscow area", Aliases: []string{"W-SU"}, StdOffset: 10800},
+	{Name: "Europe/Paris", Countries: []string{"FR", "MC"}, Lat: 48.866667, Lon: 2.333333, StdOffset: 3600},
+	{Name: "Europe/Prague", Countries: []string{"CZ", "SK"}, Lat: 50.083333, Lon: 14.433333, Aliases: []string{"Europe/Bratislava"}, StdOffset: 3600},
+	{Name: "Europe/Riga", Countries: []string{"LV"}, Lat: 56.95, Lon: 24.1, StdOffset: 7200},
+	{Name: "Europe/Rome", Countries: []string{"IT", "SM", "VA"}, Lat: 41.9, Lon: 12.483333, Aliases: []string{"Europe/San_Marino", "Europe/Vatican"}, StdOffset: 3600},
+	{Name: "Europe/Samara", Countries: []string{"RU"}, Lat: 53.2, Lon: 50.15, Comment: "MSK+01 - Samara, Udmurtia", StdOffset: 14400},
+	{Name: "Europe/Saratov", Countries: []string{"RU"}, Lat: 51.566667, Lon: 46.033333, Comment: "MSK+01 - Saratov", StdOffset: 14400},
+	{Name: "Europe/Simferopol", Countries: []string{"RU", "UA"}, Lat: 44.95, Lon: 34.1, Comment: "Crimea", StdOffset: 10800},
+	{Name: "Europe/Sofia", Countries: []string{"BG"}, Lat: 42.683333, Lon: 23.316667, StdOffset: 7200},
+	{Name: "Europe/Tallinn", Countries: []string{"EE"}, Lat: 59.416667, Lon: 24.75, StdOffset: 7200},
+	{Name: "Europe/Tirane", Countries: []string{"AL"}, Lat: 41.333333, Lon: 19.833333, StdOffset: 3600},
+	{Name: "Europe/Ulyanovsk", Countries: []string{"RU"}, Lat: 54.333333, Lon: 48.4, Comment: "MSK+01 - Ulyanovsk", StdOffset: 14400},
+	{Name: "Europe/Vienna", Countries: []string{"AT"}, Lat: 48.216667, Lon: 16.333333, StdOffset: 3600},
+	{Name: "Europe/Vilnius", Countries: []string{"LT"}, Lat: 54.683333, Lon: 25.316667, StdOffset: 7200},
+	{Name: "Europe/Volgograd", Countries: []string{"RU"}, Lat: 48.733333, Lon: 44.416667, Comment: "MSK+00 - Volgograd", StdOffset: 10800},
+	{Name: "Europe/Warsaw", Countries: []string{"PL"}, Lat: 52.25, Lon: 21, Aliases: []string{"Poland"}, StdOffset: 3600},
+	{Name: "Europe/Zurich", Countries: []string{"CH", "DE", "LI"}, Lat: 47.383333, Lon: 8.533333, Comment: "Büsingen", Aliases: []string{"Europe/Busingen"}, StdOffset: 3600},
+	{Name: "Indian/Chagos", Countries: []string{"IO"}, Lat: -7.333333, Lon: 72.416667, StdOffset: 21600},
+	{Name: "Indian/Maldives", Countries: []string{"MV", "TF"}, Lat: 4.166667, Lon: 73.5, Comment: "Kerguelen, St Paul I, Amsterdam I", StdOffset: 18000},
+	{Name: "Indian/Mauritius", Countries: []string{"MU"}, Lat: -20.166667, Lon: 57.5, StdOffset: 14400},
+	{Name: "Pacific/Apia", Countries: []string{"WS"}, Lat: -13.833333, Lon: -171.733333, StdOffset: 46800},
+	{Name: "Pacific/Auckland", Countries: []string{"NZ", "AQ"}, Lat: -36.866667, Lon: 174.766667, Comment: "New Zealand time", Aliases: []string{"Antarctica/South_Pole", "NZ"}, StdOffset: 43200},
+	{Name: "Pacific/Bougainville", Countries: []string{"PG"}, Lat: -6.216667, Lon: 155.566667, Comment: "Bougainville", StdOffset: 39600},
+	{Name: "Pacific/Chatham", Countries: []string{"NZ"}, Lat: -43.95, Lon: -176.55, Comment: "Chatham Islands", Aliases: []string{"NZ-CHAT"}, StdOffset: 45900},
+	{Name: "Pacific/Easter", Countries: []string{"CL"}, Lat: -27.15, Lon: -109.433333, Comment: "Easter Island", Aliases: []string{"Chile/EasterIsland"}, StdOffset: -21600},
+	{Name: "Pacific/Efate", Countries: []string{"VU"}, Lat: -17.666667, Lon: 168.416667, StdOffset: 39600},
+	{Name: "Pacific/Fakaofo", Countries: []string{"TK"}, Lat: -9.366667, Lon: -171.233333, StdOffset: 46800},
+	{Name: "Pacific/Fiji", Countries: []string{"FJ"}, Lat: -18.133333, Lon: 178.416667, StdOffset: 43200},
+	{Name: "Pacific/Galapagos", Countries: []string{"EC"}, Lat: -0.9, Lon: -89.6, Comment: "Galápagos Islands", StdOffset: -21600},
+	{Name: "Pacific/Gambier", Countries: []string{"PF"}, Lat: -23.133333, Lon: -134.95, Comment: "Gambier Islands", StdOffset: -32400},
+	{Name: "Pacific/Guadalcanal", Countries: []string{"SB", "FM"}, Lat: -9.533333, Lon: 160.2, Comment: "Pohnpei", Aliases: []string{"Pacific/Ponape"}, StdOffset: 39600},
+	{Name: "Pacific/Guam", Countries: []string{"GU", "MP"}, Lat: 13.466667, Lon: 144.75, StdOffset: 36000},
+	{Name: "Pacific/Honolulu", Countries: []string{"US"}, Lat: 21.306944, Lon: -157.858333, Comment: "Hawaii", Aliases: []string{"Pacific/Johnston", "US/Hawaii"}, StdOffset: -36000},
+	{Name: "Pacific/Kanton", Countries: []string{"KI"}, Lat: -2.783333, Lon: -171.716667, Comment: "Phoenix Islands", Aliases: []string{"Pacific/Enderbury"}, StdOffset: 46800},
+	{Name: "Pacific/Kiritimati", Countries: []string{"KI"}, Lat: 1.866667, Lon: -157.333333, Comment: "Line Islands", StdOffset: 50400},
+	{Name: "Pacific/Kosrae", Countries: []string{"FM"}, Lat: 5.316667, Lon: 162.983333, Comment: "Kosrae", StdOffset: 39600},
+	{Name: "Pacific/Kwajalein", Countries: []string{"MH"}, Lat: 9.083333, Lon: 167.333333, Comment: "Kwajalein", Aliases: []string{"Kwajalein"}, StdOffset: 43200},
+	{Name: "Pacific/Marquesas", Countries: []string{"PF"}, Lat: -9, Lon: -139.5, Comment: "Marquesas Islands", StdOffset: -34200},
+	{Name: "Pacific/Nauru", Countries: []string{"NR"}, Lat: -0.516667, Lon: 166.916667, StdOffset: 43200},
+	{Name: "Pacific/Niue", Countries: []string{"NU"}, Lat: -19.016667, Lon: -169.916667, StdOffset: -39600},
+	{Name: "Pacific/Norfolk", Countries: []string{"NF"}, Lat: -29.05, Lon: 167.966667, StdOffset: 39600},
+	{Name: "Pacific/Noumea", Countries: []string{"NC"}, Lat: -22.266667, Lon: 166.45, StdOffset: 39600},
+	{Name: "Pacific/Pago_Pago", Countries: []string{"AS", "UM"}, Lat: -14.266667, Lon: -170.7, Comment: "Midway", Aliases: []string{"Pacific/Samoa", "US/Samoa"}, StdOffset: -39600},
+	{Name: "Pacific/Palau", Countries: []string{"PW"}, Lat: 7.333333, Lon: 134.483333, StdOffset: 32400},
+	{Name: "Pacific/Pitcairn", Countries: []string{"PN"}, Lat: -25.066667, Lon: -130.083333, StdOffset: -28800},
+	{Name: "Pacific/Port_Moresby", Countries: []string{"PG", "AQ", "FM"}, Lat: -9.5, Lon: 147.166667, Comment: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d'Urville", Aliases: []string{"Pacific/Truk", "Pacific/Yap"}, StdOffset: 36000},
+	{Name: "Pacific/Rarotonga", Countries: []string{"CK"}, Lat: -21.233333, Lon: -159.766667, StdOffset: -36000},
+	{Name: "Pacific/Tahiti", Countries: []string{"PF"}, Lat: -17.533333, Lon: -149.566667, Comment: "Society Islands", StdOffset: -36000},
+	{Name: "Pacific/Tarawa", Countries: []string{"KI", "MH", "TV", "UM", "WF"}, Lat: 1.416667, Lon: 173, Comment: "Gilberts, Marshalls, Wake", StdOffset: 43200},
+	{Name: "Pacific/Tongatapu", Countries: []string{"TO"}, Lat: -21.133333, Lon: -175.2, StdOffset: 46800},
+}
@@ -0,0 +1,402 @@
+package aeon
+
+import (
+    "strings"
+    "time"
+)
+
+// 上下午标记
+const (
+    meridiemNone = iota
+    meridiemAM
+    meridiemPM
+)
+
+var (
+    // cjkUnits 中文日期时间单位，id 为字段索引 (0-5: 年月日时分秒)。
+    cjkUnits = [...]struct {
+        unit string
+        id   int
+    }{
+        {"年", 0}, {"月", 1}, {"日", 2}, {"号", 2}, {"號", 2},
+        {"时", 3}, {"時", 3}, {"点", 3}, {"點", 3}, {"分", 4}, {"秒", 5},
+    }
+
+    // cjkMeridiem 中文时段标记
+    cjkMeridiem = [...]struct {
+        word string
+        mer  int
+    }{
+        {"凌晨", meridiemAM}, {"早上", meridiemAM}, {"上午", meridiemAM},
+        {"中午", meridiemPM}, {"下午", meridiemPM}, {"傍晚", meridiemPM}, {"晚上", meridiemPM},
+    }
+
+    // cjkWeekdayPrefix 中文星期前缀，其后紧跟 “日天一二三四五六” 之一。
+    cjkWeekdayPrefix = [...]string{"星期", "礼拜", "禮拜", "周", "週"}
+
+    // cjkWeekdays 中文星期数字 (索引即 time.Weekday，“天” 与 “日” 同义)
+    cjkWeekdays = [...]string{"日", "一", "二", "三", "四", "五", "六", "天"}
+
+    // cjkSeps 可忽略的中文标点
+    cjkSeps = [...]string{"，", "、", "　"}
+
+    // fillers 可忽略的英文虚词，如 "Jan 2 at 3pm"、"the 2nd of January"。
+    fillers = [...]string{"at", "of", "the", "on", "t"}
+
+    // ordinals 序数词后缀，如 "2nd"、"21st"。
+    ordinals = [...]string{"st", "nd", "rd", "th"}
+)
+
+// hasWord 判断 s[i:] 是否包含单词或非 ASCII 字符 (日期时间分隔符 'T' 除外)
+func hasWord(s string, i int) bool {
+    for ; i < len(s); i++ {
+        if c := s[i]; c >= 0x80 || (bt[c]&kAlpha != 0 && c != 'T') {
+            return true
+        }
+    }
+    return false
+}
+
+// to24 将 12 小时制的小时转换为 24 小时制，h 超过 12 时原样返回。
+func to24(h, mer int) int {
+    if mer == meridiemNone || h > 12 {
+        return h
+    }
+    if h == 12 {
+        h = 0
+    }
+    if mer == meridiemPM {
+        h += 12
+    }
+    return h
+}
+
+// englishMeridiem 识别 s[i:j] 处的 "am"/"pm" 或 "a.m."/"p.m."，返回标记与结束位置。
+func englishMeridiem(s string, i, j int) (int, int) {
+    mer := meridiemNone
+    switch w := s[i:j]; {
+    case eqFold(w, "am"):
+        return meridiemAM, j
+    case eqFold(w, "pm"):
+        return meridiemPM, j
+    case eqFold(w, "a"):
+        mer = meridiemAM
+    case eqFold(w, "p"):
+        mer = meridiemPM
+    default:
+        return meridiemNone, i
+    }
+
+    // a.m. / p.m.
+    if j+1 < len(s) && s[j] == '.' && s[j+1]|0x20 == 'm' {
+        if j += 2; j < len(s) && s[j] == '.' {
+            j++
+        }
+        return mer, j
+    }
+
+    return meridiemNone, i
+}
+
+// matchAny 返回 s 以 words 中哪个词开头，未匹配返回 -1。
+func matchAny(s string, words []string) int {
+    for i, w := range words {
+        if strings.HasPrefix(s, w) {
+            return i
+        }
+    }
+    return -1
+}
+
+// parseWords 解析包含月份/星期名称、上下午标记或中文单位的日期时间。
+//
+// 支持的形式如：
+//   - "2 January 2025"、"Jan 2, 2025 3:04 PM"、"Thursday, the 2nd of January 2025"
+//   - "2025年1月2日 星期四 下午3点"、"2025年1月2日 15时04分05秒"、"1月2日 下午3点半"
+//
+// 规则：
+//   - 带单位或名称的数字直接定位到对应字段，其余数字按日期顺序填入剩余字段。
+//   - 缺少年份时使用 loc 中的当前年份；仅有时间时与 parseFast 一致，日期由 o.Anchor 确定。
+//   - 出现星期名称时，必须与日期一致，否则返回 ErrWeekday。
+func parseWords(s string, loc *time.Location, o *ParseOptions) (time.Time, error) {
+    var (
+        f    [6]int // 年 月 日 时 分 秒
+        set  uint8  // 已定位的字段位
+        ns   int
+        wd   = time.Weekday(-1)
+        mer  = meridiemNone
+        nums [6]int // 未定位的数字块
+        lens [6]int // 数字块的位数
+        k    int
+        ord  [12]byte // 数字块依次对应的字段 (见 record)，未定位的数字块记为其在 nums 中的序号
+        no   int
+    )
+
+    put := func(id, v int) bool {
+        if set&(1<<id) != 0 {
+            return false
+        }
+        f[id], set = v, set|1<<id
+        return true
+    }
+    putNum := func(id, v int) bool { // 由数字块定位的字段
+        if !put(id, v) {
+            return false
+        }
+        ord[no], no = fieldChars[id], no+1
+        return true
+    }
+
+    n := len(s)
+    for i := 0; i < n; {
+        c := s[i]
+        switch {
+        case isDigit(c):
+            j := skipDigit(s, i)
+            if j-i > 9 {
+                return time.Time{}, ErrSyntax
+            }
+            v := num(s, i, j)
+
+            if j < n && s[j] == ':' { // 时钟 hh:mm[:ss[.nnn]]
+                if !putNum(3, v) {
+                    return time.Time{}, ErrSyntax
+                }
+                for id := 4; id <= 5 && j < n && s[j] == ':'; id++ {
+                    i, j = j+1, skipDigit(s, j+1)
+                    if j-i < 1 || j-i > 2 || !putNum(id, num(s, i, j)) {
+                        return time.Time{}, ErrSyntax
+                    }
+                }
+                if j+1 < n && s[j] == '.' && isDigit(s[j+1]) {
+                    ns, j = parseNanoseconds(s, n, j)
+                }
+                i = j
+                continue
+            }
+
+            if u := matchUnit(s[j:]); u >= 0 { // 中文单位：2025年、3点、5分
+                if !putNum(cjkUnits[u].id, v) {
+                    return time.Time{}, ErrSyntax
+                }
+                i = j + len(cjkUnits[u].unit)
+                if cjkUnits[u].id == 3 && strings.HasPrefix(s[i:], "半") { // 3点半
+                    put(4, 30)
+                    i += len("半")
+                }
+                continue
+            }
+
+            if e := skipAlpha(s, j); e > j {
+                if matchFold(s[j:e], ordinals[:]) { // 2nd
+                    if !putNum(2, v) {
+                        return time.Time{}, ErrSyntax
+                    }
+                    i = e
+                    continue
+                }
+                if m, _ := englishMeridiem(s, j, e); m != meridiemNone { // 3pm
+                    if !putNum(3, v) {
+                        return time.Time{}, ErrSyntax
+                    }
+                    i = j
+                    continue
+                }
+            }
+
+            if k == len(nums) {
+                return time.Time{}, ErrSyntax
+            }
+            nums[k], lens[k], ord[no] = v, j-i, byte(k)
+            k, no, i = k+1, no+1, j
+        case bt[c]&kAlpha != 0:
+            j := skipAlpha(s, i)
+            w := s[i:j]
+            if m := lookupMonth(w); m > 0 {
+                if !put(1, m) {
+                    return time.Time{}, ErrSyntax
+                }
+            } else if d := lookupWeekday(w); d >= 0 {
+                wd = d
+            } else if m, e := englishMeridiem(s, i, j); m != meridiemNone {
+                mer, j = m, e
+            } else if !matchFold(w, fillers[:]) {
+                return time.Time{}, ErrSyntax
+            }
+            i = j
+        case c >= 0x80:
+            rest := s[i:]
+            if p := matchAny(rest, cjkWeekdayPrefix[:]); p >= 0 { // 星期四、周日
+                rest = rest[len(cjkWeekdayPrefix[p]):]
+                d := matchAny(rest, cjkWeekdays[:])
+                if d < 0 {
+                    return time.Time{}, ErrSyntax
+                }
+                wd, i = time.Weekday(d%7), n-len(rest)+len(cjkWeekdays[d])
+            } else if m := matchMeridiem(rest); m >= 0 { // 下午
+                mer, i = cjkMeridiem[m].mer, i+len(cjkMeridiem[m].word)
+            } else if p = matchAny(rest, cjkSeps[:]); p >= 0 {
+                i += len(cjkSeps[p])
+            } else {
+                return time.Time{}, ErrSyntax
+            }
+        default: // ASCII 分隔符
+            i++
+        }
+    }
+
+    var at [6]byte
+    if k > 0 && !o.fillDate(&f, &set, nums[:k], lens[:k], at[:k]) {
+        return time.Time{}, ErrSyntax
+    }
+
+    const date, clock = 0b000111, 0b111000
+    switch {
+    case set&date == 0 && set&clock == 0:
+        return time.Time{}, ErrSyntax
+    case set&date == 0: // 仅有时间
+        if wd >= 0 {
+            return time.Time{}, ErrSyntax
+        }
+        f[1], f[2] = 1, 1
+    case set&1 == 0: // 缺少年份
+        f[0] = time.Now().In(loc).Year()
+    }
+
+    if set&0b110 != 0b110 && set&date != 0 { // 日期字段不完整 (如只有 "2025年")
+        if set&2 == 0 {
+            f[1] = 1
+        }
+        if set&4 == 0 {
+            f[2] = 1
+        }
+    }
+
+    if mer != meridiemNone {
+        if set&(1<<3) == 0 {
+            return time.Time{}, ErrSyntax
+        }
+        if o.Strict && f[3] > 12 { // 如 "13:00 PM"
+            return time.Time{}, ErrRange
+        }
+        f[3] = to24(f[3], mer)
+    }
+
+    y, m, d := f[0], f[1], f[2]
+    if m < 1 || m > 12 || d < 1 || d > DaysIn(y, m) || f[3] > 23 || f[4] > 59 || f[5] > 59 {
+        return time.Time{}, ErrRange
+    }
+
+    if wd >= 0 && weekday(y, m, d) != wd {
+        return time.Time{}, ErrWeekday
+    }
+
+    if o.tr != nil {
+        for i := 0; i < no; i++ {
+            if ord[i] < byte(len(at)) {
+                ord[i] = at[ord[i]]
+            }
+        }
+        o.tr.hour12 = mer != meridiemNone
+        o.tr.n += copy(o.tr.fields[o.tr.n:], ord[:no])
+    }
+
+    if set&date == 0 {
+        return o.clock(f[3], f[4], f[5], ns, loc)
+    }
+
+    return time.Date(y, time.Month(m), d, f[3], f[4], f[5], ns, loc), nil
+}
+
+// fillDate 将未定位的数字块按日期顺序填入尚未赋值的年月日字段。
+//
+// 顺序规则：
+//   - 月份已由名称或单位确定时，1-2 位数字为日，3 位以上或大于 31 的数字为年。
+//   - 首个数字块为 3 位以上时为 年-月-日。
+//   - 恰有三个数字块时按 o.DateOrder 解释；两个数字块时按 o.DateOrder 解释为月日或日月。
+//
+// 两位年份按 o.Pivot 展开，at[i] 记录 nums[i] 填入的字段 (见 record)。
+func (o *ParseOptions) fillDate(f *[6]int, set *uint8, nums, lens []int, at []byte) bool {
+    var ids [3]int
+    switch {
+    case *set&2 != 0:
+        for i, v := range nums {
+            id := 2 // 日
+            if lens[i] >= 3 || v > 31 || *set&4 != 0 {
+                id = 0 // 年
+            }
+            if *set&(1<<id) != 0 {
+                return false
+            }
+            if id == 0 && lens[i] <= 2 {
+                v = o.year(v)
+            }
+            f[id], *set, at[i] = v, *set|1<<id, fieldChars[id]
+        }
+        return true
+    case *set&0b111 != 0: // 部分日期字段已由单位确定，其余依次填入
+        ids = [3]int{0, 1, 2}
+    case lens[0] >= 3:
+        ids = [3]int{0, 1, 2}
+    case len(nums) == 3:
+        var order DateOrder
+        f[0], f[1], f[2], order = o.resolve(nums[0], nums[1], nums[2], '/', lens[2] <= 2)
+        *set |= 0b111
+        copy(at, orderFields[order])
+        return true
+    case len(nums) == 2 && (o.DateOrder == DMY || (o.DateOrder == AutoOrder && nums[0] > 12)):
+        ids = [3]int{2, 1, 0}
+    default:
+        ids = [3]int{1, 2, 0}
+    }
+
+    i := 0
+    for _, id := range ids {
+        if i == len(nums) {
+            break
+        }
+        if *set&(1<<id) != 0 {
+            continue
+        }
+        v := nums[i]
+        if id == 0 && lens[i] <= 2 {
+            v = o.year(v)
+        }
+        f[id], *set, at[i], i = v, *set|1<<id, fieldChars[id], i+1
+    }
+
+    return i == len(nums)
+}
+
+// matchUnit 返回 s 开头的中文单位在 cjkUnits 中的索引，未匹配返回 -1。
+func matchUnit(s string) int {
+    if len(s) == 0 || s[0] < 0x80 {
+        return -1
+    }
+    for i, u := range cjkUnits {
+        if strings.HasPrefix(s, u.unit) {
+            return i
+        }
+    }
+    return -1
+}
+
+// matchMeridiem 返回 s 开头的中文时段标记在 cjkMeridiem 中的索引，未匹配返回 -1。
+func matchMeridiem(s string) int {
+    for i, m := range cjkMeridiem {
+        if strings.HasPrefix(s, m.word) {
+            return i
+        }
+    }
+    return -1
+}
+
+// matchFold 判断 w 是否忽略大小写等于 words 中的某个词
+func matchFold(w string, words []string) bool {
+    for _, x := range words {
+        if eqFold(w, x) {
+            return true
+        }
+    }
+    return false
+}